@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/internal/endpoint"
 	"github.com/carrot/go-pinterest/models"
 )
 
@@ -10,6 +11,7 @@ import (
 type BoardsController struct {
 	wreckerClient *wrecker.Wrecker
 	Pins          *BoardsPinsController
+	Sections      *BoardsSectionsController
 }
 
 // NewBoardsController instantiates a new BoardsController.
@@ -17,16 +19,23 @@ func NewBoardsController(wc *wrecker.Wrecker) *BoardsController {
 	return &BoardsController{
 		wreckerClient: wc,
 		Pins:          newBoardsPinsController(wc),
+		Sections:      newBoardsSectionsController(wc),
 	}
 }
 
 // Fetch loads a board from the board_spec (username/board-slug)
 // Endpoint: [GET] /v1/boards/<board_spec:board>/
 func (bc *BoardsController) Fetch(boardSpec string) (*models.Board, error) {
+	// Build path
+	path, err := endpoint.New("boards").Board(boardSpec).Build()
+	if err != nil {
+		return nil, err
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = new(models.Board)
-	httpResp, err := bc.wreckerClient.Get("/boards/"+boardSpec).
+	httpResp, err := withOperation(bc.wreckerClient, "Boards.Fetch").Get(path).
 		URLParam("fields", models.BOARD_FIELDS).
 		Into(resp).
 		Execute()
@@ -43,21 +52,26 @@ func (bc *BoardsController) Fetch(boardSpec string) (*models.Board, error) {
 // BoardCreateOptionals is a struct that represents the optional parameters
 // that can be passed to the Create endpoint
 type BoardCreateOptionals struct {
-	Description string
+	Description OptionalString
 }
 
 // Create makes a new board
 // Endpoint: [POST] /v1/boards/
 func (bc *BoardsController) Create(boardName string, optionals *BoardCreateOptionals) (*models.Board, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardCreateOptionals{}
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = new(models.Board)
-	httpResp, err := bc.wreckerClient.Post("/boards/").
+	request := withOperation(bc.wreckerClient, "Boards.Create").Post("/boards/").
 		URLParam("fields", models.BOARD_FIELDS).
 		FormParam("name", boardName).
-		FormParam("description", optionals.Description).
-		Into(resp).
-		Execute()
+		Into(resp)
+	optionals.Description.formParam(request, "description")
+	httpResp, err := request.Execute()
 
 	// Check Error
 	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
@@ -71,22 +85,33 @@ func (bc *BoardsController) Create(boardName string, optionals *BoardCreateOptio
 // BoardUpdateOptionals is a struct that represents the optional parameters
 // that can be passed to the Update endpoint
 type BoardUpdateOptionals struct {
-	Name        string
-	Description string
+	Name        OptionalString
+	Description OptionalString
 }
 
 // Update updates an existing board
 // Endpoint: [PATCH] /v1/boards/<board_spec:board>/
 func (bc *BoardsController) Update(boardSpec string, optionals *BoardUpdateOptionals) (*models.Board, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardUpdateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.New("boards").Board(boardSpec).Build()
+	if err != nil {
+		return nil, err
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = new(models.Board)
-	httpResp, err := bc.wreckerClient.Patch("/boards/"+boardSpec+"/").
+	request := withOperation(bc.wreckerClient, "Boards.Update").Patch(path).
 		URLParam("fields", models.BOARD_FIELDS).
-		FormParam("name", optionals.Name).
-		FormParam("description", optionals.Description).
-		Into(resp).
-		Execute()
+		Into(resp)
+	optionals.Name.formParam(request, "name")
+	optionals.Description.formParam(request, "description")
+	httpResp, err := request.Execute()
 
 	// Check Error
 	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
@@ -100,10 +125,16 @@ func (bc *BoardsController) Update(boardSpec string, optionals *BoardUpdateOptio
 // Delete deletes an existing board
 // Endpoint: [DELETE] /v1/boards/<board_spec:board>/
 func (bc *BoardsController) Delete(boardSpec string) error {
+	// Build path
+	path, err := endpoint.New("boards").Board(boardSpec).Build()
+	if err != nil {
+		return err
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = ""
-	httpResp, err := bc.wreckerClient.Delete("/boards/" + boardSpec + "/").
+	httpResp, err := withOperation(bc.wreckerClient, "Boards.Delete").Delete(path).
 		Into(resp).
 		Execute()
 
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/internal/endpoint"
 	"github.com/carrot/go-pinterest/models"
 )
 
@@ -21,19 +22,31 @@ func newBoardsPinsController(wc *wrecker.Wrecker) *BoardsPinsController {
 // BoardsPinsFetchOptionals is a struct that represents the optional parameters
 // that can be passed to the Fetch endpoint
 type BoardsPinsFetchOptionals struct {
-	Cursor string
+	Cursor OptionalString
 }
 
 // Fetch loads a board from the board_spec (username/board-slug)
 // Endpoint: [GET] /v1/boards/<board_spec:board>/pins/
 func (bpc *BoardsPinsController) Fetch(boardSpec string, optionals *BoardsPinsFetchOptionals) (*[]models.Pin, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardsPinsFetchOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.New("boards").Board(boardSpec).Segment("pins").Build()
+	if err != nil {
+		return nil, err
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = &[]models.Pin{}
-	httpResp, err := bpc.wreckerClient.Get("/boards/"+boardSpec+"/pins/").
+	request := withOperation(bpc.wreckerClient, "Boards.Pins.Fetch").Get(path).
 		URLParam("fields", models.PIN_FIELDS).
-		Into(resp).
-		Execute()
+		Into(resp)
+	optionals.Cursor.urlParam(request, "cursor")
+	httpResp, err := request.Execute()
 
 	// Check Error
 	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
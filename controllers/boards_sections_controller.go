@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/models"
+)
+
+// BoardsSectionsController is the controller that is responsible for all
+// /v1/boards/<board_spec:board>/sections/ endpoints in the Pinterest API.
+type BoardsSectionsController struct {
+	wreckerClient *wrecker.Wrecker
+	Pins          *BoardsSectionsPinsController
+}
+
+// newBoardsSectionsController instantiates a new BoardsSectionsController.
+func newBoardsSectionsController(wc *wrecker.Wrecker) *BoardsSectionsController {
+	return &BoardsSectionsController{
+		wreckerClient: wc,
+		Pins:          newBoardsSectionsPinsController(wc),
+	}
+}
+
+// BoardsSectionsFetchOptionals is a struct that represents the optional parameters
+// that can be passed to the Fetch endpoint
+type BoardsSectionsFetchOptionals struct {
+	Cursor OptionalString
+}
+
+// Fetch loads the sections of a board
+// Endpoint: [GET] /v1/boards/<board_spec:board>/sections/
+func (bsc *BoardsSectionsController) Fetch(boardSpec string, optionals *BoardsSectionsFetchOptionals) (*[]models.BoardSection, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardsSectionsFetchOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.New("boards").Board(boardSpec).Segment("sections").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.BoardSection{}
+	request := withOperation(bsc.wreckerClient, "Boards.Sections.Fetch").Get(path).
+		URLParam("fields", models.BOARD_SECTION_FIELDS).
+		Into(resp)
+	optionals.Cursor.urlParam(request, "cursor")
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.BoardSection), &resp.Page, nil
+}
+
+// Create makes a new section on a board
+// Endpoint: [POST] /v1/boards/<board_spec:board>/sections/
+func (bsc *BoardsSectionsController) Create(boardSpec string, title string) (*models.BoardSection, error) {
+	// Build path
+	path, err := endpoint.New("boards").Board(boardSpec).Segment("sections").Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.BoardSection)
+	httpResp, err := withOperation(bsc.wreckerClient, "Boards.Sections.Create").Post(path).
+		URLParam("fields", models.BOARD_SECTION_FIELDS).
+		FormParam("title", title).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.BoardSection), nil
+}
+
+// BoardsSectionsUpdateOptionals is a struct that represents the optional parameters
+// that can be passed to the Update endpoint
+type BoardsSectionsUpdateOptionals struct {
+	Title OptionalString
+}
+
+// Update updates (renames) an existing section of a board
+// Endpoint: [PATCH] /v1/boards/<board_spec:board>/sections/<section>/
+func (bsc *BoardsSectionsController) Update(boardSpec string, section string, optionals *BoardsSectionsUpdateOptionals) (*models.BoardSection, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardsSectionsUpdateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.New("boards").Board(boardSpec).Segment("sections").Id("section", section).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.BoardSection)
+	request := withOperation(bsc.wreckerClient, "Boards.Sections.Update").Patch(path).
+		URLParam("fields", models.BOARD_SECTION_FIELDS).
+		Into(resp)
+	optionals.Title.formParam(request, "title")
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.BoardSection), nil
+}
+
+// Delete deletes an existing section of a board.  The pins in
+// the section are not deleted, but moved to the board itself.
+// Endpoint: [DELETE] /v1/boards/<board_spec:board>/sections/<section>/
+func (bsc *BoardsSectionsController) Delete(boardSpec string, section string) error {
+	// Build path
+	path, err := endpoint.New("boards").Board(boardSpec).Segment("sections").Id("section", section).Build()
+	if err != nil {
+		return err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	httpResp, err := withOperation(bsc.wreckerClient, "Boards.Sections.Delete").Delete(path).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return err
+	}
+
+	// OK
+	return nil
+}
@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/models"
+)
+
+// BoardsSectionsPinsController is the controller that is responsible for all
+// /v1/boards/<board_spec:board>/sections/<section>/pins/ endpoints
+// in the Pinterest API.
+type BoardsSectionsPinsController struct {
+	wreckerClient *wrecker.Wrecker
+}
+
+// newBoardsSectionsPinsController instantiates a new BoardsSectionsPinsController.
+func newBoardsSectionsPinsController(wc *wrecker.Wrecker) *BoardsSectionsPinsController {
+	return &BoardsSectionsPinsController{
+		wreckerClient: wc,
+	}
+}
+
+// BoardsSectionsPinsFetchOptionals is a struct that represents the optional parameters
+// that can be passed to the Fetch endpoint
+type BoardsSectionsPinsFetchOptionals struct {
+	Cursor OptionalString
+}
+
+// Fetch loads the pins in a section of a board
+// Endpoint: [GET] /v1/boards/<board_spec:board>/sections/<section>/pins/
+func (bspc *BoardsSectionsPinsController) Fetch(boardSpec string, section string, optionals *BoardsSectionsPinsFetchOptionals) (*[]models.Pin, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardsSectionsPinsFetchOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.New("boards").Board(boardSpec).Segment("sections").Id("section", section).Segment("pins").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.Pin{}
+	request := withOperation(bspc.wreckerClient, "Boards.Sections.Pins.Fetch").Get(path).
+		URLParam("fields", models.PIN_FIELDS).
+		Into(resp)
+	optionals.Cursor.urlParam(request, "cursor")
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.Pin), &resp.Page, nil
+}
@@ -26,7 +26,7 @@ func (mbc *MeBoardsController) Fetch() (*[]models.Board, error) {
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = &[]models.Board{}
-	httpResp, err := mbc.wreckerClient.Get("/me/boards/").
+	httpResp, err := withOperation(mbc.wreckerClient, "Me.Boards.Fetch").Get("/me/boards/").
 		URLParam("fields", models.BOARD_FIELDS).
 		Into(resp).
 		Execute()
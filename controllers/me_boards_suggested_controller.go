@@ -23,24 +23,30 @@ func newMeBoardsSuggestedController(wc *wrecker.Wrecker) *MeBoardsSuggestedContr
 // parameters for the Fetch method
 type MeBoardsSuggestedFetchOptionals struct {
 	Count int32
-	Pin   string
+	Pin   OptionalString
 }
 
 // Fetch loads board suggestions for the logged in user
 // Endpoint: [GET] /v1/me/boards/suggested/
 func (mbsc *MeBoardsSuggestedController) Fetch(optionals *MeBoardsSuggestedFetchOptionals) (*[]models.Board, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &MeBoardsSuggestedFetchOptionals{}
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = &[]models.Board{}
-	request := mbsc.wreckerClient.Get("/me/boards/suggested/").
+	request := withOperation(mbsc.wreckerClient, "Me.Boards.Suggested.Fetch").Get("/me/boards/suggested/").
 		URLParam("fields", models.BOARD_FIELDS).
 		Into(resp)
 	if optionals.Count != 0 {
 		request.URLParam("count", strconv.Itoa(int(optionals.Count)))
 	}
-	if optionals.Pin != "" {
-		request.URLParam("pin", optionals.Pin)
+	if err := optionals.Pin.validateId("pin"); err != nil {
+		return nil, err
 	}
+	optionals.Pin.urlParam(request, "pin")
 	httpResp, err := request.Execute()
 
 	// Check Error
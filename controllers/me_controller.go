@@ -12,6 +12,7 @@ type MeController struct {
 	Boards        *MeBoardsController
 	Followers     *MeFollowersController
 	Following     *MeFollowingController
+	Likes         *MeLikesController
 	Pins          *MePinsController
 	Search        *MeSearchController
 }
@@ -23,6 +24,7 @@ func NewMeController(wc *wrecker.Wrecker) *MeController {
 		Boards:        newMeBoardsController(wc),
 		Followers:     newMeFollowersController(wc),
 		Following:     newMeFollowingController(wc),
+		Likes:         newMeLikesController(wc),
 		Pins:          newMePinsController(wc),
 		Search:        newMeSearchController(wc),
 	}
@@ -34,7 +36,7 @@ func (mc *MeController) Fetch() (*models.User, error) {
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = new(models.User)
-	httpResp, err := mc.wreckerClient.Get("/me/").
+	httpResp, err := withOperation(mc.wreckerClient, "Me.Fetch").Get("/me/").
 		URLParam("fields", models.USER_FIELDS).
 		Into(resp).
 		Execute()
@@ -21,21 +21,24 @@ func newMeFollowersController(wc *wrecker.Wrecker) *MeFollowersController {
 // MeFollowersFetchOptionals is a struct that represents the optional
 // parameters for the Fetch method
 type MeFollowersFetchOptionals struct {
-	Cursor string
+	Cursor OptionalString
 }
 
 // Fetch loads the users that follow the logged in user
 // Endpoint: [GET] /v1/me/boards/followers/
 func (mfc *MeFollowersController) Fetch(optionals *MeFollowersFetchOptionals) (*[]models.User, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &MeFollowersFetchOptionals{}
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = &[]models.User{}
-	request := mfc.wreckerClient.Get("/me/followers/").
+	request := withOperation(mfc.wreckerClient, "Me.Followers.Fetch").Get("/me/followers/").
 		URLParam("fields", models.USER_FIELDS).
 		Into(resp)
-	if optionals.Cursor != "" {
-		request.URLParam("cursor", optionals.Cursor)
-	}
+	optionals.Cursor.urlParam(request, "cursor")
 	httpResp, err := request.Execute()
 
 	// Check Error
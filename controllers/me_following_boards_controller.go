@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/internal/endpoint"
 	"github.com/carrot/go-pinterest/models"
 )
 
@@ -21,21 +22,24 @@ func newMeFollowingBoardsController(wc *wrecker.Wrecker) *MeFollowingBoardsContr
 // MeFollowingBoardsFetchOptionals is a struct that represents the optional
 // parameters for the Fetch method
 type MeFollowingBoardsFetchOptionals struct {
-	Cursor string
+	Cursor OptionalString
 }
 
 // Fetch loads the boards that the authorized user follows
 // Endpoint: [GET] /v1/me/following/boards/
 func (mfbc *MeFollowingBoardsController) Fetch(optionals *MeFollowingBoardsFetchOptionals) (*[]models.Board, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &MeFollowingBoardsFetchOptionals{}
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = &[]models.Board{}
-	request := mfbc.wreckerClient.Get("/me/following/boards/").
+	request := withOperation(mfbc.wreckerClient, "Me.Following.Boards.Fetch").Get("/me/following/boards/").
 		URLParam("fields", models.BOARD_FIELDS).
 		Into(resp)
-	if optionals.Cursor != "" {
-		request.URLParam("cursor", optionals.Cursor)
-	}
+	optionals.Cursor.urlParam(request, "cursor")
 	httpResp, err := request.Execute()
 
 	// Check Error
@@ -50,10 +54,16 @@ func (mfbc *MeFollowingBoardsController) Fetch(optionals *MeFollowingBoardsFetch
 // Create follows a board for the authorized user
 // Endpoint: [POST] /v1/me/following/boards/
 func (mfbc *MeFollowingBoardsController) Create(boardSpec string) error {
+	// Parse board spec
+	spec, err := models.ParseBoardSpec(boardSpec)
+	if err != nil {
+		return err
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
-	httpResp, err := mfbc.wreckerClient.Post("/me/following/boards/").
-		FormParam("board", boardSpec).
+	httpResp, err := withOperation(mfbc.wreckerClient, "Me.Following.Boards.Create").Post("/me/following/boards/").
+		FormParam("board", spec.String()).
 		Into(resp).
 		Execute()
 
@@ -69,9 +79,15 @@ func (mfbc *MeFollowingBoardsController) Create(boardSpec string) error {
 // Delete unfollows a board for the authorized user
 // Endpoint: [DELETE] /v1/me/following/boards/
 func (mfbc *MeFollowingBoardsController) Delete(boardSpec string) error {
+	// Build path
+	path, err := endpoint.New("me", "following", "boards").Board(boardSpec).Build()
+	if err != nil {
+		return err
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
-	httpResp, err := mfbc.wreckerClient.Delete("/me/following/boards/" + boardSpec + "/").
+	httpResp, err := withOperation(mfbc.wreckerClient, "Me.Following.Boards.Delete").Delete(path).
 		Into(resp).
 		Execute()
 
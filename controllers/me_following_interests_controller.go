@@ -1,7 +1,10 @@
 package controllers
 
 import (
+	"fmt"
+
 	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/internal/endpoint"
 	"github.com/carrot/go-pinterest/models"
 )
 
@@ -21,21 +24,24 @@ func newMeFollowingInterestsController(wc *wrecker.Wrecker) *MeFollowingInterest
 // MeFollowingInterestsFetchOptionals is a struct that represents the optional
 // parameters for the Fetch method
 type MeFollowingInterestsFetchOptionals struct {
-	Cursor string
+	Cursor OptionalString
 }
 
 // Fetch loads the authorized users interests
 // Endpoint: [GET] /v1/me/following/interests/
 func (mfic *MeFollowingInterestsController) Fetch(optionals *MeFollowingInterestsFetchOptionals) (*[]models.Interest, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &MeFollowingInterestsFetchOptionals{}
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = &[]models.Interest{}
-	request := mfic.wreckerClient.Get("/me/following/interests/").
+	request := withOperation(mfic.wreckerClient, "Me.Following.Interests.Fetch").Get("/me/following/interests/").
 		URLParam("fields", models.INTEREST_FIELDS).
 		Into(resp)
-	if optionals.Cursor != "" {
-		request.URLParam("cursor", optionals.Cursor)
-	}
+	optionals.Cursor.urlParam(request, "cursor")
 	httpResp, err := request.Execute()
 
 	// Check Error
@@ -46,3 +52,51 @@ func (mfic *MeFollowingInterestsController) Fetch(optionals *MeFollowingInterest
 	// OK
 	return resp.Data.(*[]models.Interest), &resp.Page, nil
 }
+
+// Create follows an interest for the authorized user
+// Endpoint: [POST] /v1/me/following/interests/
+func (mfic *MeFollowingInterestsController) Create(interest string) error {
+	// Validate interest
+	if !models.IsValidId(interest) {
+		return fmt.Errorf("invalid interest id %q", interest)
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	httpResp, err := withOperation(mfic.wreckerClient, "Me.Following.Interests.Create").Post("/me/following/interests/").
+		FormParam("interest", interest).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return err
+	}
+
+	// OK
+	return nil
+}
+
+// Delete unfollows an interest for the authorized user
+// Endpoint: [DELETE] /v1/me/following/interests/<interest>/
+func (mfic *MeFollowingInterestsController) Delete(interest string) error {
+	// Build path
+	path, err := endpoint.New("me", "following", "interests").Id("interest", interest).Build()
+	if err != nil {
+		return err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	httpResp, err := withOperation(mfic.wreckerClient, "Me.Following.Interests.Delete").Delete(path).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return err
+	}
+
+	// OK
+	return nil
+}
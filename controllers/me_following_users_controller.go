@@ -1,9 +1,11 @@
 package controllers
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/internal/endpoint"
 	"github.com/carrot/go-pinterest/models"
 )
 
@@ -23,22 +25,25 @@ func newMeFollowingUsersController(wc *wrecker.Wrecker) *MeFollowingUsersControl
 // FollowingUsersControllerFetchOptionals is a struct that represents the optional
 // parameters for the Fetch method
 type FollowingUsersControllerFetchOptionals struct {
-	Cursor string
+	Cursor OptionalString
 	Limit  int
 }
 
 // Fetch loads the users that the authorized user follows
 // Endpoint: [GET] /v1/me/following/users/
 func (c *MeFollowingUsersController) Fetch(optionals *FollowingUsersControllerFetchOptionals) (*[]models.User, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &FollowingUsersControllerFetchOptionals{}
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = &[]models.User{}
-	request := c.wreckerClient.Get("/me/following/users/").
+	request := withOperation(c.wreckerClient, "Me.Following.Users.Fetch").Get("/me/following/users/").
 		URLParam("fields", models.USER_FIELDS).
 		Into(resp)
-	if optionals.Cursor != "" {
-		request.URLParam("cursor", optionals.Cursor)
-	}
+	optionals.Cursor.urlParam(request, "cursor")
 	if optionals.Limit != 0 {
 		request.URLParam("limit", strconv.Itoa(optionals.Limit))
 	}
@@ -56,9 +61,14 @@ func (c *MeFollowingUsersController) Fetch(optionals *FollowingUsersControllerFe
 // Create follows a user
 // Endpoint: [POST] /v1/me/following/users/
 func (c *MeFollowingUsersController) Create(user string) error {
+	// Validate user
+	if !models.IsValidUsername(user) {
+		return fmt.Errorf("invalid username %q", user)
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
-	httpResp, err := c.wreckerClient.Post("/me/following/users/").
+	httpResp, err := withOperation(c.wreckerClient, "Me.Following.Users.Create").Post("/me/following/users/").
 		FormParam("user", user).
 		Into(resp).
 		Execute()
@@ -75,9 +85,15 @@ func (c *MeFollowingUsersController) Create(user string) error {
 // Delete unfollows a user
 // Endpoint: [DELETE] /v1/me/following/users/
 func (c *MeFollowingUsersController) Delete(user string) error {
+	// Build path
+	path, err := endpoint.New("me", "following", "users").User(user).Build()
+	if err != nil {
+		return err
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
-	httpResp, err := c.wreckerClient.Delete("/me/following/users/" + user + "/").
+	httpResp, err := withOperation(c.wreckerClient, "Me.Following.Users.Delete").Delete(path).
 		Into(resp).
 		Execute()
 
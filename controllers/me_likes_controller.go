@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/models"
+)
+
+// MeLikesController is the controller that is responsible for all
+// /v1/me/likes/ endpoints in the Pinterest API.
+type MeLikesController struct {
+	wreckerClient *wrecker.Wrecker
+}
+
+// newMeLikesController instantiates a new MeLikesController
+func newMeLikesController(wc *wrecker.Wrecker) *MeLikesController {
+	return &MeLikesController{
+		wreckerClient: wc,
+	}
+}
+
+// MeLikesFetchOptionals is a struct that represents the optional parameters
+// that can be passed to the Fetch endpoint
+type MeLikesFetchOptionals struct {
+	Cursor OptionalString
+}
+
+// Fetch loads the Pins that the logged in user has liked
+// Endpoint: [GET] /v1/me/likes/
+func (mlc *MeLikesController) Fetch(optionals *MeLikesFetchOptionals) (*[]models.Pin, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &MeLikesFetchOptionals{}
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.Pin{}
+	request := withOperation(mlc.wreckerClient, "Me.Likes.Fetch").Get("/me/likes/").
+		URLParam("fields", models.PIN_FIELDS).
+		Into(resp)
+	optionals.Cursor.urlParam(request, "cursor")
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.Pin), &resp.Page, nil
+}
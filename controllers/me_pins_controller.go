@@ -21,21 +21,24 @@ func newMePinsController(wc *wrecker.Wrecker) *MePinsController {
 // MePinsFetchOptionals is a struct that represents the optional parameters
 // that can be passed to the Fetch endpoint
 type MePinsFetchOptionals struct {
-	Cursor string
+	Cursor OptionalString
 }
 
 // Fetch loads all of the logged in user's Pins
 // Endpoint: [GET] /v1/me/pins/
 func (mpc *MePinsController) Fetch(optionals *MePinsFetchOptionals) (*[]models.Pin, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &MePinsFetchOptionals{}
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = &[]models.Pin{}
-	request := mpc.wreckerClient.Get("/me/pins/").
+	request := withOperation(mpc.wreckerClient, "Me.Pins.Fetch").Get("/me/pins/").
 		URLParam("fields", models.PIN_FIELDS).
 		Into(resp)
-	if optionals.Cursor != "" {
-		request.URLParam("cursor", optionals.Cursor)
-	}
+	optionals.Cursor.urlParam(request, "cursor")
 	httpResp, err := request.Execute()
 
 	// Check Error
@@ -22,23 +22,26 @@ func newMeSearchBoardsController(wc *wrecker.Wrecker) *MeSearchBoardsController
 // MeSearchBoardsFetchOptionals is a struct that represents the optional parameters
 // that can be passed to the Fetch endpoint
 type MeSearchBoardsFetchOptionals struct {
-	Cursor string
+	Cursor OptionalString
 	Limit  int
 }
 
 // Fetch searches the logged in user's Boards
 // Endpoint: [GET] /v1/me/search/boards/
 func (msbc *MeSearchBoardsController) Fetch(query string, optionals *MeSearchBoardsFetchOptionals) (*[]models.Board, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &MeSearchBoardsFetchOptionals{}
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = &[]models.Board{}
-	request := msbc.wreckerClient.Get("/me/search/boards/").
+	request := withOperation(msbc.wreckerClient, "Me.Search.Boards.Fetch").Get("/me/search/boards/").
 		URLParam("fields", models.BOARD_FIELDS).
 		URLParam("query", query).
 		Into(resp)
-	if optionals.Cursor != "" {
-		request.URLParam("cursor", optionals.Cursor)
-	}
+	optionals.Cursor.urlParam(request, "cursor")
 	if optionals.Limit != 0 {
 		request.URLParam("limit", strconv.Itoa(optionals.Limit))
 	}
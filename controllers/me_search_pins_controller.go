@@ -22,23 +22,26 @@ func newMeSearchPinsController(wc *wrecker.Wrecker) *MeSearchPinsController {
 // MeSearchPinsFetchOptionals is a struct that represents the optional parameters
 // that can be passed to the Fetch endpoint
 type MeSearchPinsFetchOptionals struct {
-	Cursor string
+	Cursor OptionalString
 	Limit  int
 }
 
 // Fetch searches the logged in user's Pins
 // Endpoint: [GET] /v1/me/search/pins/
 func (mspc *MeSearchPinsController) Fetch(query string, optionals *MeSearchPinsFetchOptionals) (*[]models.Pin, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &MeSearchPinsFetchOptionals{}
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = &[]models.Pin{}
-	request := mspc.wreckerClient.Get("/me/search/pins/").
+	request := withOperation(mspc.wreckerClient, "Me.Search.Pins.Fetch").Get("/me/search/pins/").
 		URLParam("fields", models.PIN_FIELDS).
 		URLParam("query", query).
 		Into(resp)
-	if optionals.Cursor != "" {
-		request.URLParam("cursor", optionals.Cursor)
-	}
+	optionals.Cursor.urlParam(request, "cursor")
 	if optionals.Limit != 0 {
 		request.URLParam("limit", strconv.Itoa(optionals.Limit))
 	}
@@ -23,7 +23,7 @@ func newOAuthTokenController(wc *wrecker.Wrecker) *OAuthTokenController {
 func (otc *OAuthTokenController) Create(clientId, clientSecret, accessCode string) (*models.AccessToken, error) {
 	// Build + execute request
 	accessToken := new(models.AccessToken)
-	httpResp, err := otc.wreckerClient.Post("/oauth/token").
+	httpResp, err := withOperation(otc.wreckerClient, "OAuth.Token.Create").Post("/oauth/token").
 		URLParam("grant_type", "authorization_code").
 		URLParam("client_id", clientId).
 		URLParam("client_secret", clientSecret).
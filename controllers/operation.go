@@ -0,0 +1,31 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/transport"
+)
+
+// withOperation returns a copy of wc whose requests are named after the
+// API call (such as "Pins.Create") that they are sent for, so they can
+// be traced.  The context that wc's requests are sent with (see the
+// Client's WithContext) is kept.
+func withOperation(wc *wrecker.Wrecker, operation string) *wrecker.Wrecker {
+	httpClient := http.DefaultClient
+	if wc.HttpClient != nil {
+		httpClient = wc.HttpClient
+	}
+	client := *httpClient
+	scope := &transport.Scope{Operation: operation, Base: client.Transport}
+	if outer, ok := client.Transport.(*transport.Scope); ok {
+		named := *outer
+		named.Operation = operation
+		scope = &named
+	}
+	client.Transport = scope
+
+	named := *wc
+	named.HttpClient = &client
+	return &named
+}
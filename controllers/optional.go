@@ -0,0 +1,67 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/models"
+)
+
+// OptionalString is a string parameter that is only sent to the
+// Pinterest API when it has been explicitly set.
+//
+// The zero value is unset, and will not be sent at all.  Use String to
+// set a value; setting the empty string explicitly clears the field.
+type OptionalString struct {
+	value string
+	set   bool
+}
+
+// String returns an OptionalString that is set to value.
+func String(value string) OptionalString {
+	return OptionalString{value: value, set: true}
+}
+
+// Get returns the value of the OptionalString, and whether it was set.
+func (o OptionalString) Get() (string, bool) {
+	return o.value, o.set
+}
+
+// IsSet returns true if the OptionalString has been explicitly set.
+func (o OptionalString) IsSet() bool {
+	return o.set
+}
+
+// formParam adds the OptionalString to request as a form
+// parameter, only if it has been set.
+func (o OptionalString) formParam(request *wrecker.Request, key string) {
+	if o.set {
+		request.FormParam(key, o.value)
+	}
+}
+
+// urlParam adds the OptionalString to request as a URL
+// parameter, only if it has been set.
+func (o OptionalString) urlParam(request *wrecker.Request, key string) {
+	if o.set {
+		request.URLParam(key, o.value)
+	}
+}
+
+// validateId returns an error if the OptionalString is set,
+// but is not a well formed id.
+func (o OptionalString) validateId(kind string) error {
+	if o.set && !models.IsValidId(o.value) {
+		return fmt.Errorf("invalid %s id %q", kind, o.value)
+	}
+	return nil
+}
+
+// validateClearableId is like validateId, but also accepts the empty
+// string, which clears the field rather than naming an id.
+func (o OptionalString) validateClearableId(kind string) error {
+	if o.set && o.value == "" {
+		return nil
+	}
+	return o.validateId(kind)
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"encoding/base64"
 	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/internal/endpoint"
 	"github.com/carrot/go-pinterest/models"
 	"os"
 )
@@ -24,10 +25,16 @@ func NewPinsController(wc *wrecker.Wrecker) *PinsController {
 // Fetch loads a pin from the pin id
 // Endpoint: [GET] /v1/pins/<pin>/
 func (pc *PinsController) Fetch(pinId string) (*models.Pin, error) {
+	// Build path
+	path, err := endpoint.New("pins").Id("pin", pinId).Build()
+	if err != nil {
+		return nil, err
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = new(models.Pin)
-	httpResp, err := pc.wreckerClient.Get("/pins/"+pinId+"/").
+	httpResp, err := withOperation(pc.wreckerClient, "Pins.Fetch").Get(path).
 		URLParam("fields", models.PIN_FIELDS).
 		Into(resp).
 		Execute()
@@ -44,28 +51,44 @@ func (pc *PinsController) Fetch(pinId string) (*models.Pin, error) {
 // PinCreateOptionals is a struct that represents the optional parameters
 // that can be passed to the Create endpoint
 type PinCreateOptionals struct {
-	Link     string
-	ImageUrl string
+	Link     OptionalString
+	ImageUrl OptionalString
 	Image    *os.File
+
+	// Section is the id of the board section to create the pin in.
+	Section OptionalString
 }
 
 // Create creates a new pin
 // Endpoint: [POST] /v1/pins/
 func (pc *PinsController) Create(boardSpec string, note string, optionals *PinCreateOptionals) (*models.Pin, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &PinCreateOptionals{}
+	}
+
+	// Parse board spec
+	spec, err := models.ParseBoardSpec(boardSpec)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = new(models.Pin)
-	request := pc.wreckerClient.Post("/pins/").
+	request := withOperation(pc.wreckerClient, "Pins.Create").Post("/pins/").
 		URLParam("fields", models.PIN_FIELDS).
-		FormParam("board", boardSpec).
+		FormParam("board", spec.String()).
 		FormParam("note", note).
 		Into(resp)
-	if optionals.Link != "" {
-		request.FormParam("link", optionals.Link)
+	optionals.Link.formParam(request, "link")
+	if err := optionals.Section.validateId("section"); err != nil {
+		return nil, err
 	}
+	optionals.Section.formParam(request, "board_section")
 	// Handle Image
-	if optionals.ImageUrl != "" {
-		request.FormParam("image_url", optionals.ImageUrl)
+	if optionals.ImageUrl.IsSet() {
+		optionals.ImageUrl.formParam(request, "image_url")
 	} else if fileInfo, err := optionals.Image.Stat(); err == nil {
 		// Create a new buffer based on file size
 		var size int64 = fileInfo.Size()
@@ -90,32 +113,105 @@ func (pc *PinsController) Create(boardSpec string, note string, optionals *PinCr
 	return resp.Data.(*models.Pin), nil
 }
 
+// PinSaveOptionals is a struct that represents the optional parameters
+// that can be passed to the Save endpoint
+type PinSaveOptionals struct {
+	// Note overrides the note of the source pin on the new pin.
+	Note OptionalString
+
+	// Section is the id of the board section to save the pin in.
+	Section OptionalString
+}
+
+// Save saves (repins) an existing pin to one of the authorized
+// user's boards, and returns the newly created pin.
+// Endpoint: [POST] /v1/pins/<pin>/save/
+func (pc *PinsController) Save(pinId string, boardSpec string, optionals *PinSaveOptionals) (*models.Pin, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &PinSaveOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.New("pins").Id("pin", pinId).Segment("save").Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse board spec
+	spec, err := models.ParseBoardSpec(boardSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Pin)
+	request := withOperation(pc.wreckerClient, "Pins.Save").Post(path).
+		URLParam("fields", models.PIN_FIELDS).
+		FormParam("board", spec.String()).
+		Into(resp)
+	optionals.Note.formParam(request, "note")
+	if err := optionals.Section.validateId("section"); err != nil {
+		return nil, err
+	}
+	optionals.Section.formParam(request, "board_section")
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Pin), nil
+}
+
 // PinUpdateOptionals is a struct that represents the optional parameters
 // that can be passed to the Update endpoint
 type PinUpdateOptionals struct {
-	Board string
-	Note  string
-	Link  string
+	Board OptionalString
+	Note  OptionalString
+	Link  OptionalString
+
+	// Section is the id of the board section to move the pin to,
+	// or the empty string to remove the pin from its section.
+	Section OptionalString
 }
 
 // Update updates an existing pin
 // Endpoint: [PATCH] /v1/pins/<pin>/
 func (pc *PinsController) Update(pinId string, optionals *PinUpdateOptionals) (*models.Pin, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &PinUpdateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.New("pins").Id("pin", pinId).Build()
+	if err != nil {
+		return nil, err
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = new(models.Pin)
-	request := pc.wreckerClient.Patch("/pins/"+pinId+"/").
+	request := withOperation(pc.wreckerClient, "Pins.Update").Patch(path).
 		URLParam("fields", models.PIN_FIELDS).
 		Into(resp)
-	if optionals.Board != "" {
-		request.FormParam("board", optionals.Board)
-	}
-	if optionals.Note != "" {
-		request.FormParam("note", optionals.Note)
+	if board, ok := optionals.Board.Get(); ok {
+		spec, err := models.ParseBoardSpec(board)
+		if err != nil {
+			return nil, err
+		}
+		request.FormParam("board", spec.String())
 	}
-	if optionals.Link != "" {
-		request.FormParam("link", optionals.Link)
+	optionals.Note.formParam(request, "note")
+	optionals.Link.formParam(request, "link")
+	if err := optionals.Section.validateClearableId("section"); err != nil {
+		return nil, err
 	}
+	optionals.Section.formParam(request, "board_section")
 	httpResp, err := request.Execute()
 
 	// Check Error
@@ -130,9 +226,15 @@ func (pc *PinsController) Update(pinId string, optionals *PinUpdateOptionals) (*
 // Delete deletes an existing pin
 // Endpoint: [DELETE] /v1/pins/<pin>/
 func (pc *PinsController) Delete(pinId string) error {
+	// Build path
+	path, err := endpoint.New("pins").Id("pin", pinId).Build()
+	if err != nil {
+		return err
+	}
+
 	// Execute Request
 	resp := new(models.Response)
-	httpResp, err := pc.wreckerClient.Delete("/pins/" + pinId + "/").Execute()
+	httpResp, err := withOperation(pc.wreckerClient, "Pins.Delete").Delete(path).Execute()
 
 	// Check Error
 	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/models"
+)
+
+// UsersBoardsController is the controller that is responsible for all
+// /v1/users/<user>/boards/ endpoints in the Pinterest API.
+type UsersBoardsController struct {
+	wreckerClient *wrecker.Wrecker
+}
+
+// newUsersBoardsController instantiates a new UsersBoardsController
+func newUsersBoardsController(wc *wrecker.Wrecker) *UsersBoardsController {
+	return &UsersBoardsController{
+		wreckerClient: wc,
+	}
+}
+
+// UsersBoardsFetchOptionals is a struct that represents the optional parameters
+// that can be passed to the Fetch endpoint
+type UsersBoardsFetchOptionals struct {
+	Cursor OptionalString
+	Limit  int
+
+	// Fields overrides which fields are loaded for each board.
+	// Defaults to models.BOARD_FIELDS.
+	Fields []string
+}
+
+// Fetch loads a user's public boards
+// Endpoint: [GET] /v1/users/<user>/boards/
+func (ubc *UsersBoardsController) Fetch(username string, optionals *UsersBoardsFetchOptionals) (*[]models.Board, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &UsersBoardsFetchOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.New("users").User(username).Segment("boards").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.Board{}
+	request := withOperation(ubc.wreckerClient, "Users.Boards.Fetch").Get(path).
+		Into(resp)
+	if len(optionals.Fields) > 0 {
+		request.URLParam("fields", strings.Join(optionals.Fields, ","))
+	} else {
+		request.URLParam("fields", models.BOARD_FIELDS)
+	}
+	optionals.Cursor.urlParam(request, "cursor")
+	if optionals.Limit != 0 {
+		request.URLParam("limit", strconv.Itoa(optionals.Limit))
+	}
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.Board), &resp.Page, nil
+}
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/internal/endpoint"
 	"github.com/carrot/go-pinterest/models"
 )
 
@@ -9,22 +10,32 @@ import (
 // /v1/users/ endpoints in the Pinterest API.
 type UsersController struct {
 	wreckerClient *wrecker.Wrecker
+	Boards        *UsersBoardsController
+	Pins          *UsersPinsController
 }
 
 // NewUsersController instantiates a new UsersController.
 func NewUsersController(wc *wrecker.Wrecker) *UsersController {
 	return &UsersController{
 		wreckerClient: wc,
+		Boards:        newUsersBoardsController(wc),
+		Pins:          newUsersPinsController(wc),
 	}
 }
 
 // Fetch loads a user from their username.
 // Endpoint: [GET] /v1/users/<user>/
 func (uc *UsersController) Fetch(username string) (*models.User, error) {
+	// Build path
+	path, err := endpoint.New("users").User(username).Build()
+	if err != nil {
+		return nil, err
+	}
+
 	// Build + execute request
 	resp := new(models.Response)
 	resp.Data = new(models.User)
-	httpResp, err := uc.wreckerClient.Get("/users/"+username+"/").
+	httpResp, err := withOperation(uc.wreckerClient, "Users.Fetch").Get(path).
 		URLParam("fields", models.USER_FIELDS).
 		Into(resp).
 		Execute()
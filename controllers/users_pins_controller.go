@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/BrandonRomano/wrecker"
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/models"
+)
+
+// UsersPinsController is the controller that is responsible for all
+// /v1/users/<user>/pins/ endpoints in the Pinterest API.
+type UsersPinsController struct {
+	wreckerClient *wrecker.Wrecker
+}
+
+// newUsersPinsController instantiates a new UsersPinsController
+func newUsersPinsController(wc *wrecker.Wrecker) *UsersPinsController {
+	return &UsersPinsController{
+		wreckerClient: wc,
+	}
+}
+
+// UsersPinsFetchOptionals is a struct that represents the optional parameters
+// that can be passed to the Fetch endpoint
+type UsersPinsFetchOptionals struct {
+	Cursor OptionalString
+	Limit  int
+
+	// Fields overrides which fields are loaded for each pin.
+	// Defaults to models.PIN_FIELDS.
+	Fields []string
+}
+
+// Fetch loads a user's public pins
+// Endpoint: [GET] /v1/users/<user>/pins/
+func (upc *UsersPinsController) Fetch(username string, optionals *UsersPinsFetchOptionals) (*[]models.Pin, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &UsersPinsFetchOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.New("users").User(username).Segment("pins").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.Pin{}
+	request := withOperation(upc.wreckerClient, "Users.Pins.Fetch").Get(path).
+		Into(resp)
+	if len(optionals.Fields) > 0 {
+		request.URLParam("fields", strings.Join(optionals.Fields, ","))
+	} else {
+		request.URLParam("fields", models.PIN_FIELDS)
+	}
+	optionals.Cursor.urlParam(request, "cursor")
+	if optionals.Limit != 0 {
+		request.URLParam("limit", strconv.Itoa(optionals.Limit))
+	}
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.Pin), &resp.Page, nil
+}
@@ -0,0 +1,1709 @@
+package pinterest_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/carrot/go-pinterest"
+	"github.com/carrot/go-pinterest/controllers"
+	"github.com/carrot/go-pinterest/models"
+	"github.com/carrot/go-pinterest/pinterestcache"
+	"github.com/carrot/go-pinterest/transport"
+	"github.com/carrot/go-pinterest/v5"
+	v5controllers "github.com/carrot/go-pinterest/v5/controllers"
+	v5models "github.com/carrot/go-pinterest/v5/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// In order for 'go test' to run this suite, we need to create
+// a normal test function and pass our suite to suite.Run.
+func TestFakeServerTestSuite(t *testing.T) {
+	suite.Run(t, new(FakeServerTestSuite))
+}
+
+// A test suite that runs our Client against a local fake of the
+// Pinterest API, so no network connection or AccessToken is needed.
+type FakeServerTestSuite struct {
+	suite.Suite
+	server    *httptest.Server
+	client    *pinterest.Client
+	v5Client  *v5.Client
+	mutex     sync.Mutex
+	responses map[string]fakeResponse
+	requests  []fakeRequest
+}
+
+// fakeResponse is a canned response from the fake server.
+type fakeResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       string
+}
+
+// fakeRequest is a request that was received by the fake server.
+type fakeRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Form   url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// rewriteTransport sends every request to the fake server,
+// regardless of which host it was addressed to.
+type rewriteTransport struct {
+	target *url.URL
+}
+
+func (rt *rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = rt.target.Scheme
+	req.URL.Host = rt.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// SetupTest starts the fake server, and builds a client that talks to it.
+func (suite *FakeServerTestSuite) SetupTest() {
+	suite.responses = map[string]fakeResponse{}
+	suite.requests = nil
+	suite.server = httptest.NewServer(http.HandlerFunc(suite.serveHTTP))
+
+	target := suite.targetURL()
+	suite.client = pinterest.NewClient().
+		RegisterAccessToken("fake-access-token").
+		SetHttpClient(&http.Client{
+			Transport: &rewriteTransport{target: target},
+		})
+	suite.v5Client = v5.NewClient().
+		RegisterAccessToken("fake-access-token").
+		SetHttpClient(&http.Client{
+			Transport: &rewriteTransport{target: target},
+		})
+}
+
+// targetURL returns the URL of the fake server.
+func (suite *FakeServerTestSuite) targetURL() *url.URL {
+	target, _ := url.Parse(suite.server.URL)
+	return target
+}
+
+// TearDownTest stops the fake server.
+func (suite *FakeServerTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+// respond registers a canned response for the method and path.
+func (suite *FakeServerTestSuite) respond(method, path string, statusCode int, body string) {
+	suite.mutex.Lock()
+	defer suite.mutex.Unlock()
+	suite.responses[method+" "+path] = fakeResponse{StatusCode: statusCode, Body: body}
+}
+
+// respondWithHeader registers a canned response with headers
+// for the method and path.
+func (suite *FakeServerTestSuite) respondWithHeader(method, path string, statusCode int, header http.Header, body string) {
+	suite.mutex.Lock()
+	defer suite.mutex.Unlock()
+	suite.responses[method+" "+path] = fakeResponse{StatusCode: statusCode, Header: header, Body: body}
+}
+
+// lastRequest returns the most recent request the fake server received.
+func (suite *FakeServerTestSuite) lastRequest() fakeRequest {
+	suite.mutex.Lock()
+	defer suite.mutex.Unlock()
+	if len(suite.requests) == 0 {
+		suite.T().Fatal("the fake server did not receive any requests")
+	}
+	return suite.requests[len(suite.requests)-1]
+}
+
+// requestCount returns the number of requests the fake server received.
+func (suite *FakeServerTestSuite) requestCount() int {
+	suite.mutex.Lock()
+	defer suite.mutex.Unlock()
+	return len(suite.requests)
+}
+
+// allRequests returns every request the fake server received, in order.
+func (suite *FakeServerTestSuite) allRequests() []fakeRequest {
+	suite.mutex.Lock()
+	defer suite.mutex.Unlock()
+	return append([]fakeRequest(nil), suite.requests...)
+}
+
+// serveHTTP records each request, and replies with the matching
+// canned response (or a 404 if there is none).
+func (suite *FakeServerTestSuite) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	suite.mutex.Lock()
+	defer suite.mutex.Unlock()
+
+	body, _ := ioutil.ReadAll(r.Body)
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	r.ParseForm()
+	suite.requests = append(suite.requests, fakeRequest{
+		Method: r.Method,
+		Path:   r.URL.EscapedPath(),
+		Query:  r.URL.Query(),
+		Form:   r.PostForm,
+		Header: r.Header,
+		Body:   body,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Ratelimit-Remaining", "999")
+	response, ok := suite.responses[r.Method+" "+r.URL.EscapedPath()]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message": "not found", "type": "api"}`))
+		return
+	}
+	for key, values := range response.Header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(response.StatusCode)
+	w.Write([]byte(response.Body))
+}
+
+// =======================================
+// ========== Without Optionals ==========
+// =======================================
+
+// TestEndpointsWithoutOptionals tests that every endpoint can be called
+// with nil optionals, and that nothing optional is sent in that case.
+func (suite *FakeServerTestSuite) TestEndpointsWithoutOptionals() {
+	object := `{"data": {"id": "1"}}`
+	list := `{"data": [{"id": "1"}], "page": {"cursor": "next-cursor"}}`
+	suite.respond("POST", "/v1/boards/", http.StatusCreated, object)
+	suite.respond("PATCH", "/v1/boards/BrandonRRomano/go-pinterest/", http.StatusOK, object)
+	suite.respond("GET", "/v1/boards/BrandonRRomano/go-pinterest/pins/", http.StatusOK, list)
+	suite.respond("POST", "/v1/pins/", http.StatusCreated, object)
+	suite.respond("PATCH", "/v1/pins/1/", http.StatusOK, object)
+	suite.respond("GET", "/v1/me/boards/suggested/", http.StatusOK, list)
+	suite.respond("GET", "/v1/me/followers/", http.StatusOK, list)
+	suite.respond("GET", "/v1/me/following/boards/", http.StatusOK, list)
+	suite.respond("GET", "/v1/me/following/interests/", http.StatusOK, list)
+	suite.respond("GET", "/v1/me/following/users/", http.StatusOK, list)
+	suite.respond("GET", "/v1/me/pins/", http.StatusOK, list)
+	suite.respond("GET", "/v1/me/search/boards/", http.StatusOK, list)
+	suite.respond("GET", "/v1/me/search/pins/", http.StatusOK, list)
+
+	// Boards.Create
+	board, err := suite.client.Boards.Create("Go Pinterest", nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "1", board.Id)
+	assert.Equal(suite.T(), url.Values{"name": {"Go Pinterest"}}, suite.lastRequest().Form)
+
+	// Boards.Update
+	board, err = suite.client.Boards.Update("BrandonRRomano/go-pinterest", nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "1", board.Id)
+	assert.Equal(suite.T(), 0, len(suite.lastRequest().Form))
+
+	// Boards.Pins.Fetch
+	pins, err := suite.client.Boards.Pins.Fetch("BrandonRRomano/go-pinterest", nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), 1, len(*pins))
+	assert.Equal(suite.T(), "", suite.lastRequest().Query.Get("cursor"))
+
+	// Pins.Create
+	pin, err := suite.client.Pins.Create("BrandonRRomano/go-pinterest", "Cat", nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "1", pin.Id)
+	assert.Equal(suite.T(), url.Values{
+		"board": {"BrandonRRomano/go-pinterest"},
+		"note":  {"Cat"},
+	}, suite.lastRequest().Form)
+
+	// Pins.Update
+	pin, err = suite.client.Pins.Update("1", nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "1", pin.Id)
+	assert.Equal(suite.T(), 0, len(suite.lastRequest().Form))
+
+	// Me.Boards.Suggested.Fetch
+	boards, err := suite.client.Me.Boards.Suggested.Fetch(nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), 1, len(*boards))
+
+	// Me.Followers.Fetch
+	users, page, err := suite.client.Me.Followers.Fetch(nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), 1, len(*users))
+	assert.Equal(suite.T(), "next-cursor", page.Cursor)
+
+	// Me.Following.Boards.Fetch
+	boards, page, err = suite.client.Me.Following.Boards.Fetch(nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), 1, len(*boards))
+	assert.Equal(suite.T(), "next-cursor", page.Cursor)
+
+	// Me.Following.Interests.Fetch
+	interests, page, err := suite.client.Me.Following.Interests.Fetch(nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), 1, len(*interests))
+	assert.Equal(suite.T(), "next-cursor", page.Cursor)
+
+	// Me.Following.Users.Fetch
+	users, page, err = suite.client.Me.Following.Users.Fetch(nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), 1, len(*users))
+	assert.Equal(suite.T(), "next-cursor", page.Cursor)
+
+	// Me.Pins.Fetch
+	pins, page, err = suite.client.Me.Pins.Fetch(nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), 1, len(*pins))
+	assert.Equal(suite.T(), "next-cursor", page.Cursor)
+
+	// Me.Search.Boards.Fetch
+	boards, page, err = suite.client.Me.Search.Boards.Fetch("Go", nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), 1, len(*boards))
+	assert.Equal(suite.T(), "Go", suite.lastRequest().Query.Get("query"))
+
+	// Me.Search.Pins.Fetch
+	pins, page, err = suite.client.Me.Search.Pins.Fetch("Go", nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), 1, len(*pins))
+	assert.Equal(suite.T(), "Go", suite.lastRequest().Query.Get("query"))
+
+	// Every request should have been authorized
+	for _, request := range suite.requests {
+		assert.Equal(suite.T(), "fake-access-token", request.Query.Get("access_token"))
+	}
+}
+
+// TestNotFoundFakeServer tests that errors from the fake server
+// are wrapped in a PinterestError, same as the real API.
+func (suite *FakeServerTestSuite) TestNotFoundFakeServer() {
+	_, err := suite.client.Pins.Fetch("1")
+	if pinterestError, ok := err.(*models.PinterestError); ok {
+		assert.Equal(suite.T(), http.StatusNotFound, pinterestError.StatusCode)
+		assert.Equal(suite.T(), "not found", pinterestError.Message)
+	} else {
+		// Make this error out, should always be a PinterestError
+		assert.Equal(suite.T(), true, false)
+	}
+}
+
+// =========================================
+// ========== Users.Boards / Pins ==========
+// =========================================
+
+// TestUsersBoardsAndPinsFetch tests that a user's boards and pins can be
+// paged through, and that the loaded fields can be overridden.
+func (suite *FakeServerTestSuite) TestUsersBoardsAndPinsFetch() {
+	suite.respond("GET", "/v1/users/BrandonRRomano/boards/", http.StatusOK,
+		`{"data": [{"id": "1", "name": "Go Pinterest!"}], "page": {"cursor": "boards-cursor"}}`)
+	suite.respond("GET", "/v1/users/BrandonRRomano/pins/", http.StatusOK,
+		`{"data": [{"id": "2", "note": "Gopher"}], "page": {"cursor": "pins-cursor"}}`)
+
+	// Boards, with a cursor and fields
+	boards, page, err := suite.client.Users.Boards.Fetch(
+		"BrandonRRomano",
+		&controllers.UsersBoardsFetchOptionals{
+			Cursor: controllers.String("some-cursor"),
+			Limit:  1,
+			Fields: []string{"id", "name"},
+		},
+	)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "Go Pinterest!", (*boards)[0].Name)
+	assert.Equal(suite.T(), "boards-cursor", page.Cursor)
+	assert.Equal(suite.T(), "some-cursor", suite.lastRequest().Query.Get("cursor"))
+	assert.Equal(suite.T(), "1", suite.lastRequest().Query.Get("limit"))
+	assert.Equal(suite.T(), "id,name", suite.lastRequest().Query.Get("fields"))
+
+	// Pins, with default fields
+	pins, page, err := suite.client.Users.Pins.Fetch("BrandonRRomano", nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "Gopher", (*pins)[0].Note)
+	assert.Equal(suite.T(), "pins-cursor", page.Cursor)
+	assert.Equal(suite.T(), models.PIN_FIELDS, suite.lastRequest().Query.Get("fields"))
+	_, cursorSent := suite.lastRequest().Query["cursor"]
+	assert.False(suite.T(), cursorSent)
+}
+
+// ====================================
+// ========== Me.Likes.Fetch ==========
+// ====================================
+
+// TestMeLikesFetch tests that the pins the authorized user has liked
+// can be paged through.
+func (suite *FakeServerTestSuite) TestMeLikesFetch() {
+	suite.respond("GET", "/v1/me/likes/", http.StatusOK,
+		`{"data": [{"id": "1", "note": "Liked"}], "page": {"cursor": "likes-cursor", "next": "https://api.pinterest.com/v1/me/likes/?cursor=likes-cursor"}}`)
+
+	// Load first page
+	pins, page, err := suite.client.Me.Likes.Fetch(nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), 1, len(*pins))
+	assert.Equal(suite.T(), "Liked", (*pins)[0].Note)
+	assert.Equal(suite.T(), "likes-cursor", page.Cursor)
+	assert.Equal(suite.T(), models.PIN_FIELDS, suite.lastRequest().Query.Get("fields"))
+
+	// Load second page
+	_, _, err = suite.client.Me.Likes.Fetch(
+		&controllers.MeLikesFetchOptionals{
+			Cursor: controllers.String(page.Cursor),
+		},
+	)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "likes-cursor", suite.lastRequest().Query.Get("cursor"))
+}
+
+// TestUnauthorizedMeLikesFetch tests that a 401 is wrapped in a
+// PinterestError when calling Me.Likes.Fetch
+func (suite *FakeServerTestSuite) TestUnauthorizedMeLikesFetch() {
+	suite.respond("GET", "/v1/me/likes/", http.StatusUnauthorized,
+		`{"message": "Authorization failed.", "type": "api"}`)
+
+	_, _, err := suite.client.Me.Likes.Fetch(nil)
+	if pinterestError, ok := err.(*models.PinterestError); ok {
+		assert.Equal(suite.T(), http.StatusUnauthorized, pinterestError.StatusCode)
+		assert.Equal(suite.T(), "Authorization failed.", pinterestError.Message)
+	} else {
+		// Make this error out, should always be a PinterestError
+		assert.Equal(suite.T(), true, false)
+	}
+}
+
+// ============================================================
+// ========== Me.Following.Interests.Create / Delete ==========
+// ============================================================
+
+// TestMeFollowingInterestsCD tests that an interest can be followed
+// and then unfollowed.
+func (suite *FakeServerTestSuite) TestMeFollowingInterestsCD() {
+	suite.respond("POST", "/v1/me/following/interests/", http.StatusOK, `{"data": null}`)
+	suite.respond("DELETE", "/v1/me/following/interests/955147773988/", http.StatusOK, `{"data": null}`)
+
+	// Follow
+	err := suite.client.Me.Following.Interests.Create("955147773988")
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "955147773988", suite.lastRequest().Form.Get("interest"))
+
+	// Unfollow
+	err = suite.client.Me.Following.Interests.Delete("955147773988")
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "DELETE", suite.lastRequest().Method)
+
+	// Malformed interests never make it to the server
+	requests := suite.requestCount()
+	assert.NotEqual(suite.T(), nil, suite.client.Me.Following.Interests.Create(""))
+	assert.NotEqual(suite.T(), nil, suite.client.Me.Following.Interests.Delete("9551/../me"))
+	assert.Equal(suite.T(), requests, suite.requestCount())
+}
+
+// =====================================
+// ========== Boards.Sections ==========
+// =====================================
+
+// TestBoardsSectionsCRUD tests that sections can be listed, created,
+// renamed and deleted, and that their pins can be listed.
+func (suite *FakeServerTestSuite) TestBoardsSectionsCRUD() {
+	section := `{"data": {"id": "42", "title": "Gophers", "pin_count": 3}}`
+	suite.respond("GET", "/v1/boards/BrandonRRomano/go-pinterest/sections/", http.StatusOK,
+		`{"data": [{"id": "42", "title": "Gophers", "pin_count": 3}], "page": {"cursor": "sections-cursor"}}`)
+	suite.respond("POST", "/v1/boards/BrandonRRomano/go-pinterest/sections/", http.StatusCreated, section)
+	suite.respond("PATCH", "/v1/boards/BrandonRRomano/go-pinterest/sections/42/", http.StatusOK, section)
+	suite.respond("DELETE", "/v1/boards/BrandonRRomano/go-pinterest/sections/42/", http.StatusOK, `{"data": null}`)
+	suite.respond("GET", "/v1/boards/BrandonRRomano/go-pinterest/sections/42/pins/", http.StatusOK,
+		`{"data": [{"id": "1"}], "page": {"cursor": ""}}`)
+
+	// Fetch
+	sections, page, err := suite.client.Boards.Sections.Fetch("BrandonRRomano/go-pinterest", nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "Gophers", (*sections)[0].Title)
+	assert.Equal(suite.T(), int32(3), (*sections)[0].PinCount)
+	assert.Equal(suite.T(), "sections-cursor", page.Cursor)
+
+	// Create
+	created, err := suite.client.Boards.Sections.Create("BrandonRRomano/go-pinterest", "Gophers")
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "42", created.Id)
+	assert.Equal(suite.T(), "Gophers", suite.lastRequest().Form.Get("title"))
+
+	// Update
+	_, err = suite.client.Boards.Sections.Update("BrandonRRomano/go-pinterest", "42",
+		&controllers.BoardsSectionsUpdateOptionals{
+			Title: controllers.String("Gophers!"),
+		},
+	)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "Gophers!", suite.lastRequest().Form.Get("title"))
+
+	// Pins
+	pins, _, err := suite.client.Boards.Sections.Pins.Fetch("BrandonRRomano/go-pinterest", "42", nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), 1, len(*pins))
+
+	// Delete
+	err = suite.client.Boards.Sections.Delete("BrandonRRomano/go-pinterest", "42")
+	assert.Equal(suite.T(), nil, err)
+}
+
+// TestPinsSection tests that a pin can be created in, and moved to,
+// a board section.
+func (suite *FakeServerTestSuite) TestPinsSection() {
+	suite.respond("POST", "/v1/pins/", http.StatusCreated, `{"data": {"id": "1"}}`)
+	suite.respond("PATCH", "/v1/pins/1/", http.StatusOK, `{"data": {"id": "1"}}`)
+
+	_, err := suite.client.Pins.Create("BrandonRRomano/go-pinterest", "Cat",
+		&controllers.PinCreateOptionals{
+			ImageUrl: controllers.String("http://i.imgur.com/1olmVpO.jpg"),
+			Section:  controllers.String("42"),
+		},
+	)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "42", suite.lastRequest().Form.Get("board_section"))
+
+	_, err = suite.client.Pins.Update("1",
+		&controllers.PinUpdateOptionals{
+			Section: controllers.String("43"),
+		},
+	)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), url.Values{"board_section": {"43"}}, suite.lastRequest().Form)
+
+	// An empty section removes the pin from its section
+	_, err = suite.client.Pins.Update("1",
+		&controllers.PinUpdateOptionals{
+			Section: controllers.String(""),
+		},
+	)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), url.Values{"board_section": {""}}, suite.lastRequest().Form)
+
+	// Malformed sections never make it to the server
+	_, err = suite.client.Pins.Update("1",
+		&controllers.PinUpdateOptionals{
+			Section: controllers.String("gophers"),
+		},
+	)
+	assert.NotEqual(suite.T(), nil, err)
+}
+
+// ===============================
+// ========== Pins.Save ==========
+// ===============================
+
+// TestPinsSave tests that an existing pin can be saved to a board,
+// and that the new pin is returned.
+func (suite *FakeServerTestSuite) TestPinsSave() {
+	suite.respond("POST", "/v1/pins/192880796521721688/save/", http.StatusCreated,
+		`{"data": {"id": "2", "note": "The Go Gopher", "board": {"name": "Go Pinterest 2!"}}}`)
+
+	// Without optionals
+	pin, err := suite.client.Pins.Save("192880796521721688", "https://www.pinterest.com/BrandonRRomano/go-pinterest-2/", nil)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "2", pin.Id)
+	assert.Equal(suite.T(), "Go Pinterest 2!", pin.Board.Name)
+	assert.Equal(suite.T(), url.Values{"board": {"BrandonRRomano/go-pinterest-2"}}, suite.lastRequest().Form)
+
+	// Into a section
+	_, err = suite.client.Pins.Save("192880796521721688", "BrandonRRomano/go-pinterest-2",
+		&controllers.PinSaveOptionals{
+			Section: controllers.String("42"),
+		},
+	)
+	assert.Equal(suite.T(), nil, err)
+	assert.Equal(suite.T(), "42", suite.lastRequest().Form.Get("board_section"))
+
+	// Malformed boards never make it to the server
+	requests := suite.requestCount()
+	_, err = suite.client.Pins.Save("192880796521721688", "BrandonRRomano", nil)
+	assert.NotEqual(suite.T(), nil, err)
+	assert.Equal(suite.T(), requests, suite.requestCount())
+}
+
+// =========================
+// ========== v5 ===========
+// =========================
+
+// TestV5BoardsList tests that the v5 client authorizes with a Bearer
+// token, and decodes a page of boards along with its bookmark.
+func (suite *FakeServerTestSuite) TestV5BoardsList() {
+	suite.respond("GET", "/v5/boards", 200, `{
+		"items": [{"id": "549755885175", "name": "Recipes", "privacy": "PUBLIC"}],
+		"bookmark": "next-page"
+	}`)
+
+	boards, page, err := suite.v5Client.Boards.List(&v5controllers.BoardsListOptionals{
+		Bookmark: "this-page",
+		PageSize: 25,
+	})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 1, len(*boards))
+	assert.Equal(suite.T(), "Recipes", (*boards)[0].Name)
+	assert.Equal(suite.T(), "next-page", page.Bookmark)
+
+	request := suite.lastRequest()
+	assert.Equal(suite.T(), "Bearer fake-access-token", request.Header.Get("Authorization"))
+	assert.Equal(suite.T(), "", request.Query.Get("access_token"))
+	assert.Equal(suite.T(), "this-page", request.Query.Get("bookmark"))
+	assert.Equal(suite.T(), "25", request.Query.Get("page_size"))
+}
+
+// TestRetainRaw tests that each client only populates the Raw fields of
+// the models it decodes when it was set to retain them.
+func (suite *FakeServerTestSuite) TestRetainRaw() {
+	suite.respond("GET", "/v1/pins/1/", 200, `{"data": {"id": "1", "brand_new": true, "board": {"id": "2", "section": "a"}}}`)
+	suite.respond("GET", "/v5/pins/1", 200, `{"id": "1", "brand_new": true, "media": {"images": {"150x150": {"url": "a", "size": 1}}}}`)
+	suite.respond("GET", "/v5/boards", 200, `{"items": [{"id": "2", "brand_new": true}], "bookmark": ""}`)
+
+	// Disabled by default
+	pin, err := suite.client.Pins.Fetch("1")
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 0, len(pin.Raw.JSON))
+	v5Pin, err := suite.v5Client.Pins.Fetch("1")
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 0, len(v5Pin.Raw.JSON))
+
+	// Enabled on the v1 client only
+	suite.client.SetRetainRaw(true)
+	pin, err = suite.client.Pins.Fetch("1")
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "true", string(pin.Raw.Unknown["brand_new"]))
+	assert.Equal(suite.T(), `"a"`, string(pin.Board.Raw.Unknown["section"]))
+	v5Pin, err = suite.v5Client.Pins.Fetch("1")
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 0, len(v5Pin.Raw.JSON))
+
+	// Enabled on the v5 client, for objects, maps and lists
+	suite.v5Client.SetRetainRaw(true)
+	v5Pin, err = suite.v5Client.Pins.Fetch("1")
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "true", string(v5Pin.Raw.Unknown["brand_new"]))
+	assert.Equal(suite.T(), "1", string(v5Pin.Media.Images["150x150"].Raw.Unknown["size"]))
+	boards, _, err := suite.v5Client.Boards.List(nil)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), `{"id": "2", "brand_new": true}`, string((*boards)[0].Raw.JSON))
+}
+
+// TestV5PinsCreate tests that the v5 client sends JSON bodies,
+// with only the optionals that were set.
+func (suite *FakeServerTestSuite) TestV5PinsCreate() {
+	suite.respond("POST", "/v5/pins", 201, `{"id": "1234", "board_id": "549755885175"}`)
+
+	pin, err := suite.v5Client.Pins.Create(
+		"549755885175",
+		v5models.ImageURLSource("https://example.com/image.png"),
+		&v5controllers.PinCreateOptionals{
+			Title: v5controllers.String("A pin"),
+		},
+	)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "1234", pin.Id)
+
+	request := suite.lastRequest()
+	assert.Equal(suite.T(), "application/json", request.Header.Get("Content-Type"))
+	var body map[string]interface{}
+	assert.Nil(suite.T(), json.Unmarshal(request.Body, &body))
+	assert.Equal(suite.T(), "549755885175", body["board_id"])
+	assert.Equal(suite.T(), "A pin", body["title"])
+	_, hasLink := body["link"]
+	assert.False(suite.T(), hasLink)
+	mediaSource := body["media_source"].(map[string]interface{})
+	assert.Equal(suite.T(), "image_url", mediaSource["source_type"])
+
+	// Malformed ids never reach the server
+	_, err = suite.v5Client.Pins.Create("not/a/board", v5models.ImageURLSource("https://example.com/image.png"), nil)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), 1, suite.requestCount())
+}
+
+// TestV5NotFound tests that v5 errors are returned as the
+// same PinterestError that v1 returns.
+func (suite *FakeServerTestSuite) TestV5NotFound() {
+	suite.respond("GET", "/v5/pins/1234", 404, `{"code": 50, "message": "Pin not found."}`)
+
+	pin, err := suite.v5Client.Pins.Fetch("1234")
+	assert.Nil(suite.T(), pin)
+	if pinterestError, ok := err.(*models.PinterestError); ok {
+		assert.Equal(suite.T(), http.StatusNotFound, pinterestError.StatusCode)
+		assert.Equal(suite.T(), 50, pinterestError.Code)
+		assert.Equal(suite.T(), "Pin not found.", pinterestError.Message)
+	} else {
+		assert.Equal(suite.T(), true, false)
+	}
+}
+
+// TestV5RetryPolicy tests that rate limited requests are retried.
+func (suite *FakeServerTestSuite) TestV5RetryPolicy() {
+	suite.respond("GET", "/v5/user_account", 429, `{"code": 8, "message": "Rate limited"}`)
+	suite.v5Client.SetRetryPolicy(transport.RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	})
+
+	_, err := suite.v5Client.UserAccount.Fetch()
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), 3, suite.requestCount())
+
+	// The v1 client shares the same RetryPolicy
+	suite.respond("GET", "/v1/me/", 429, `{"message": "Rate limited", "type": "api"}`)
+	suite.client.SetRetryPolicy(transport.RetryPolicy{
+		MaxAttempts: 2,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  time.Millisecond,
+	})
+	_, err = suite.client.Me.Fetch()
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), 5, suite.requestCount())
+}
+
+// TestRetryPolicyTimeout tests that the http.Client's Timeout limits each
+// attempt, rather than cutting the retries short.
+func (suite *FakeServerTestSuite) TestRetryPolicyTimeout() {
+	suite.respond("GET", "/v5/user_account", 429, `{"code": 8, "message": "Rate limited"}`)
+	suite.v5Client.
+		SetHttpClient(&http.Client{
+			Timeout:   100 * time.Millisecond,
+			Transport: &rewriteTransport{target: suite.targetURL()},
+		}).
+		SetRetryPolicy(transport.RetryPolicy{
+			MaxAttempts: 3,
+			MinBackoff:  75 * time.Millisecond,
+			MaxBackoff:  75 * time.Millisecond,
+		})
+
+	_, err := suite.v5Client.UserAccount.Fetch()
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), 3, suite.requestCount())
+}
+
+// TestV5OAuthTokenCreate tests that the client credentials are sent with
+// HTTP Basic auth, and that the token's expiry is tracked.
+func (suite *FakeServerTestSuite) TestV5OAuthTokenCreate() {
+	suite.respond("POST", "/v5/oauth/token", 200, `{
+		"access_token": "pina_new",
+		"refresh_token": "pinr_new",
+		"token_type": "bearer",
+		"expires_in": 2592000,
+		"refresh_token_expires_in": 31536000,
+		"scope": "boards:read pins:read"
+	}`)
+
+	token, err := v5.NewClient().
+		SetHttpClient(&http.Client{
+			Transport: &rewriteTransport{target: suite.targetURL()},
+		}).
+		OAuth.Token.Create("client-id", "client-secret", "some-code", "https://example.com/callback")
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "pina_new", token.AccessToken)
+	assert.Equal(suite.T(), "pinr_new", token.RefreshToken)
+	assert.False(suite.T(), token.ExpiresWithin(24*time.Hour))
+	assert.True(suite.T(), token.ExpiresWithin(31*24*time.Hour))
+
+	request := suite.lastRequest()
+	username, password, ok := (&http.Request{Header: request.Header}).BasicAuth()
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "client-id", username)
+	assert.Equal(suite.T(), "client-secret", password)
+	assert.Equal(suite.T(), "authorization_code", request.Form.Get("grant_type"))
+	assert.Equal(suite.T(), "some-code", request.Form.Get("code"))
+	assert.Equal(suite.T(), "", request.Query.Get("client_secret"))
+
+	// A persisted token keeps its expiry
+	encoded, _ := json.Marshal(token)
+	persisted := new(v5models.AccessToken)
+	assert.Nil(suite.T(), json.Unmarshal(encoded, persisted))
+	assert.True(suite.T(), token.ExpiresAt.Equal(persisted.ExpiresAt))
+}
+
+// TestV5RefreshBeforeExpiry tests that a token about to expire is
+// refreshed before the request is sent, and handed to the callback.
+func (suite *FakeServerTestSuite) TestV5RefreshBeforeExpiry() {
+	suite.respond("POST", "/v5/oauth/token", 200, `{"access_token": "pina_new", "expires_in": 2592000}`)
+	suite.respond("GET", "/v5/user_account", 200, `{"username": "someone"}`)
+
+	var persisted []*v5models.AccessToken
+	suite.v5Client.RegisterOAuthToken("client-id", "client-secret", &v5models.AccessToken{
+		AccessToken:  "pina_old",
+		RefreshToken: "pinr_old",
+		ExpiresAt:    time.Now().Add(10 * time.Second),
+	}, func(token *v5models.AccessToken) {
+		persisted = append(persisted, token)
+	})
+
+	_, err := suite.v5Client.UserAccount.Fetch()
+	assert.Nil(suite.T(), err)
+
+	requests := suite.allRequests()
+	assert.Equal(suite.T(), 2, len(requests))
+	assert.Equal(suite.T(), "refresh_token", requests[0].Form.Get("grant_type"))
+	assert.Equal(suite.T(), "pinr_old", requests[0].Form.Get("refresh_token"))
+	assert.Equal(suite.T(), "Bearer pina_new", requests[1].Header.Get("Authorization"))
+
+	// The refresh token is kept, as Pinterest didn't return a new one
+	assert.Equal(suite.T(), 1, len(persisted))
+	assert.Equal(suite.T(), "pina_new", persisted[0].AccessToken)
+	assert.Equal(suite.T(), "pinr_old", persisted[0].RefreshToken)
+	assert.Equal(suite.T(), "pina_new", suite.v5Client.AccessToken().AccessToken)
+
+	// The new token doesn't need refreshing again
+	_, err = suite.v5Client.UserAccount.Fetch()
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 3, suite.requestCount())
+}
+
+// TestV5RefreshAfterUnauthorized tests that a token the API rejects
+// is refreshed, and the request is sent once more.
+func (suite *FakeServerTestSuite) TestV5RefreshAfterUnauthorized() {
+	suite.respond("POST", "/v5/oauth/token", 200, `{"access_token": "pina_new", "expires_in": 2592000}`)
+	suite.respond("GET", "/v5/user_account", 401, `{"code": 2, "message": "Authentication failed."}`)
+
+	suite.v5Client.RegisterOAuthToken("client-id", "client-secret", &v5models.AccessToken{
+		AccessToken:  "pina_old",
+		RefreshToken: "pinr_old",
+	}, nil)
+
+	_, err := suite.v5Client.UserAccount.Fetch()
+	if pinterestError, ok := err.(*models.PinterestError); ok {
+		assert.Equal(suite.T(), http.StatusUnauthorized, pinterestError.StatusCode)
+	} else {
+		assert.Equal(suite.T(), true, false)
+	}
+
+	requests := suite.allRequests()
+	assert.Equal(suite.T(), 3, len(requests))
+	assert.Equal(suite.T(), "Bearer pina_old", requests[0].Header.Get("Authorization"))
+	assert.Equal(suite.T(), "refresh_token", requests[1].Form.Get("grant_type"))
+	assert.Equal(suite.T(), "Bearer pina_new", requests[2].Header.Get("Authorization"))
+}
+
+// TestV5UploadVideo tests the whole video upload workflow, and that
+// the video isn't sent to the storage host with the access token.
+func (suite *FakeServerTestSuite) TestV5UploadVideo() {
+	suite.respond("POST", "/v5/media", 201, `{
+		"media_id": "12345",
+		"media_type": "video",
+		"upload_url": "https://uploads.example.com/video-uploads",
+		"upload_parameters": {"key": "uploads/12345", "policy": "some-policy"}
+	}`)
+	suite.respond("POST", "/video-uploads", 204, ``)
+	suite.respond("GET", "/v5/media/12345", 200, `{"media_id": "12345", "status": "succeeded"}`)
+	suite.respond("POST", "/v5/pins", 201, `{"id": "6789"}`)
+
+	video := []byte("not really a video")
+	var stages []string
+	var sent int64
+	pin, err := suite.v5Client.UploadVideo("549755885175", bytes.NewReader(video), int64(len(video)), "https://example.com/cover.png", &v5.UploadVideoOptionals{
+		Pin: &v5controllers.PinCreateOptionals{
+			Title: v5controllers.String("A video"),
+		},
+		Progress: func(progress v5.VideoProgress) {
+			if len(stages) == 0 || stages[len(stages)-1] != progress.Stage {
+				stages = append(stages, progress.Stage)
+			}
+			if progress.Stage == v5.VIDEO_STAGE_UPLOADING {
+				sent = progress.Sent
+			}
+		},
+		PollInterval: time.Millisecond,
+	})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "6789", pin.Id)
+	assert.Equal(suite.T(), []string{
+		v5.VIDEO_STAGE_REGISTERED,
+		v5.VIDEO_STAGE_UPLOADING,
+		v5.VIDEO_STAGE_PROCESSING,
+		v5.VIDEO_STAGE_CREATED,
+	}, stages)
+	assert.Equal(suite.T(), int64(len(video)), sent)
+
+	requests := suite.allRequests()
+	assert.Equal(suite.T(), 4, len(requests))
+	upload := requests[1]
+	assert.Equal(suite.T(), "", upload.Header.Get("Authorization"))
+	assert.True(suite.T(), bytes.Contains(upload.Body, video))
+	assert.True(suite.T(), bytes.Contains(upload.Body, []byte("uploads/12345")))
+
+	var body map[string]interface{}
+	assert.Nil(suite.T(), json.Unmarshal(requests[3].Body, &body))
+	mediaSource := body["media_source"].(map[string]interface{})
+	assert.Equal(suite.T(), "video_id", mediaSource["source_type"])
+	assert.Equal(suite.T(), "12345", mediaSource["media_id"])
+	assert.Equal(suite.T(), "https://example.com/cover.png", mediaSource["cover_image_url"])
+}
+
+// TestV5UploadVideoProcessing tests that a video which takes too long
+// to process can be resumed, and that processing failures are typed.
+func (suite *FakeServerTestSuite) TestV5UploadVideoProcessing() {
+	suite.respond("POST", "/v5/media", 201, `{"media_id": "12345", "upload_url": "https://uploads.example.com/video-uploads"}`)
+	suite.respond("POST", "/video-uploads", 204, ``)
+	suite.respond("GET", "/v5/media/12345", 200, `{"media_id": "12345", "status": "processing"}`)
+	suite.respond("POST", "/v5/pins", 201, `{"id": "6789"}`)
+	optionals := &v5.UploadVideoOptionals{
+		PollInterval: time.Millisecond,
+		Timeout:      5 * time.Millisecond,
+	}
+
+	// Time out while processing
+	_, err := suite.v5Client.UploadVideo("549755885175", bytes.NewReader([]byte("video")), 5, "https://example.com/cover.png", optionals)
+	uploadError, ok := err.(*v5.VideoUploadError)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "12345", uploadError.MediaId)
+	assert.Equal(suite.T(), v5.VIDEO_STAGE_PROCESSING, uploadError.Stage)
+
+	// Resume, without uploading again
+	uploads := suite.requestCount()
+	suite.respond("GET", "/v5/media/12345", 200, `{"media_id": "12345", "status": "succeeded"}`)
+	pin, err := suite.v5Client.ResumeVideoUpload(uploadError.MediaId, "549755885175", "https://example.com/cover.png", optionals)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "6789", pin.Id)
+	assert.Equal(suite.T(), uploads+2, suite.requestCount())
+
+	// Processing failed
+	suite.respond("GET", "/v5/media/12345", 200, `{"media_id": "12345", "status": "failed"}`)
+	_, err = suite.v5Client.ResumeVideoUpload("12345", "549755885175", "https://example.com/cover.png", optionals)
+	var processingError *v5models.MediaProcessingError
+	assert.True(suite.T(), errors.As(err, &processingError))
+	assert.Equal(suite.T(), "12345", processingError.MediaId)
+}
+
+// slowTransport delays requests to one path, as a slow
+// upload would take.
+type slowTransport struct {
+	path  string
+	delay time.Duration
+	base  http.RoundTripper
+}
+
+func (st *slowTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Path == st.path {
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(st.delay):
+		}
+	}
+	return st.base.RoundTrip(req)
+}
+
+// TestV5UploadVideoTimeouts tests that uploads aren't cut short by the
+// http.Client's Timeout, and that waiting for a video to be processed
+// stops when the Client's context is done.
+func (suite *FakeServerTestSuite) TestV5UploadVideoTimeouts() {
+	suite.respond("POST", "/v5/media", 201, `{"media_id": "12345", "upload_url": "https://uploads.example.com/video-uploads"}`)
+	suite.respond("POST", "/video-uploads", 204, ``)
+	suite.respond("GET", "/v5/media/12345", 200, `{"media_id": "12345", "status": "processing"}`)
+	suite.v5Client.SetHttpClient(&http.Client{
+		Timeout: 20 * time.Millisecond,
+		Transport: &slowTransport{
+			path:  "/video-uploads",
+			delay: 50 * time.Millisecond,
+			base:  &rewriteTransport{target: suite.targetURL()},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	start := time.Now()
+	_, err := suite.v5Client.WithContext(ctx).UploadVideo("549755885175", bytes.NewReader([]byte("video")), 5, "https://example.com/cover.png",
+		&v5.UploadVideoOptionals{
+			PollInterval: time.Minute,
+			Progress: func(progress v5.VideoProgress) {
+				if progress.Stage == v5.VIDEO_STAGE_PROCESSING {
+					cancel()
+				}
+			},
+		},
+	)
+	uploadError, ok := err.(*v5.VideoUploadError)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), v5.VIDEO_STAGE_PROCESSING, uploadError.Stage)
+	assert.True(suite.T(), errors.Is(err, context.Canceled))
+	assert.True(suite.T(), time.Since(start) < time.Minute)
+}
+
+// TestV5MicroCurrency tests that amounts are parsed and
+// formatted without floating point rounding.
+func (suite *FakeServerTestSuite) TestV5MicroCurrency() {
+	cases := map[string]v5models.MicroCurrency{
+		"12.50":    12500000,
+		"0.000001": 1,
+		"-3":       -3000000,
+		".5":       500000,
+	}
+	for input, expected := range cases {
+		amount, err := v5models.ParseMicroCurrency(input)
+		assert.Nil(suite.T(), err, input)
+		assert.Equal(suite.T(), expected, amount, input)
+	}
+	for _, input := range []string{"", "1.0000001", "1,50", "1.-5", "$5"} {
+		_, err := v5models.ParseMicroCurrency(input)
+		assert.NotNil(suite.T(), err, input)
+	}
+	assert.Equal(suite.T(), "12.50", v5models.MicroCurrency(12500000).String())
+	assert.Equal(suite.T(), "0.000001", v5models.MicroCurrency(1).String())
+	assert.Equal(suite.T(), "100.00 USD", v5models.Money{Amount: v5models.Units(100), Currency: "USD"}.String())
+}
+
+// TestV5AdsCampaigns tests creating, listing and archiving campaigns,
+// which the ads API does through lists of entities.
+func (suite *FakeServerTestSuite) TestV5AdsCampaigns() {
+	suite.respond("POST", "/v5/ad_accounts/549755885175/campaigns", 200, `{
+		"items": [{"data": {"id": "626735565838", "name": "Spring", "daily_spend_cap": 25000000, "lifetime_spend_cap": null}, "exceptions": []}]
+	}`)
+
+	campaign, err := suite.v5Client.AdAccounts.Campaigns.Create("549755885175", "Spring", "AWARENESS", &v5controllers.CampaignCreateOptionals{
+		DailySpendCap:    v5controllers.Money(v5models.Units(25)),
+		LifetimeSpendCap: v5controllers.NoLimit(),
+	})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "626735565838", campaign.Id)
+	assert.Equal(suite.T(), "25.00", campaign.DailySpendCap.String())
+	assert.Nil(suite.T(), campaign.LifetimeSpendCap)
+
+	var body []map[string]interface{}
+	assert.Nil(suite.T(), json.Unmarshal(suite.lastRequest().Body, &body))
+	assert.Equal(suite.T(), 1, len(body))
+	assert.Equal(suite.T(), float64(25000000), body[0]["daily_spend_cap"])
+	lifetimeSpendCap, hasLifetimeSpendCap := body[0]["lifetime_spend_cap"]
+	assert.True(suite.T(), hasLifetimeSpendCap)
+	assert.Nil(suite.T(), lifetimeSpendCap)
+	_, hasStatus := body[0]["status"]
+	assert.False(suite.T(), hasStatus)
+
+	// List with filters
+	suite.respond("GET", "/v5/ad_accounts/549755885175/campaigns", 200, `{"items": [{"id": "626735565838"}], "bookmark": null}`)
+	campaigns, page, err := suite.v5Client.AdAccounts.Campaigns.List("549755885175", &v5controllers.AdAccountsCampaignsListOptionals{
+		EntityStatuses: []string{v5models.ENTITY_STATUS_ACTIVE, v5models.ENTITY_STATUS_PAUSED},
+	})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 1, len(*campaigns))
+	assert.Equal(suite.T(), "", page.Bookmark)
+	assert.Equal(suite.T(), []string{"ACTIVE", "PAUSED"}, suite.lastRequest().Query["entity_statuses"])
+
+	// Archive, which fails for this campaign
+	suite.respond("PATCH", "/v5/ad_accounts/549755885175/campaigns", 200, `{
+		"items": [{"data": null, "exceptions": [{"code": 2926, "message": "Campaign cannot be archived."}]}]
+	}`)
+	_, err = suite.v5Client.AdAccounts.Campaigns.Archive("549755885175", "626735565838")
+	if pinterestError, ok := err.(*models.PinterestError); ok {
+		assert.Equal(suite.T(), 2926, pinterestError.Code)
+		assert.Equal(suite.T(), "Campaign cannot be archived.", pinterestError.Message)
+	} else {
+		assert.Equal(suite.T(), true, false)
+	}
+	body = nil
+	assert.Nil(suite.T(), json.Unmarshal(suite.lastRequest().Body, &body))
+	assert.Equal(suite.T(), "626735565838", body[0]["id"])
+	assert.Equal(suite.T(), "ARCHIVED", body[0]["status"])
+}
+
+// TestV5AdsCreate tests creating an ad group and an ad.
+func (suite *FakeServerTestSuite) TestV5AdsCreate() {
+	suite.respond("POST", "/v5/ad_accounts/549755885175/ad_groups", 200, `{
+		"items": [{"data": {"id": "2680059592705", "campaign_id": "626735565838", "bid_in_micro_currency": 1500000}}]
+	}`)
+	suite.respond("POST", "/v5/ad_accounts/549755885175/ads", 200, `{
+		"items": [{"data": {"id": "687195905986", "ad_group_id": "2680059592705", "pin_id": "1234"}}]
+	}`)
+
+	bid, _ := v5models.ParseMicroCurrency("1.50")
+	adGroup, err := suite.v5Client.AdAccounts.AdGroups.Create("549755885175", "626735565838", "Gardeners", &v5controllers.AdGroupCreateOptionals{
+		BidInMicroCurrency: v5controllers.Money(bid),
+		TargetingSpec:      map[string][]string{"GEO": {"US"}},
+	})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), bid, *adGroup.BidInMicroCurrency)
+
+	ad, err := suite.v5Client.AdAccounts.Ads.Create("549755885175", adGroup.Id, "1234", "REGULAR", nil)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "687195905986", ad.Id)
+
+	// Malformed ids never reach the server
+	_, err = suite.v5Client.AdAccounts.Ads.Create("549755885175", adGroup.Id, "not-a-pin", "REGULAR", nil)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), 2, suite.requestCount())
+}
+
+// TestV5Analytics tests fetching a pin's metrics as a typed series.
+func (suite *FakeServerTestSuite) TestV5Analytics() {
+	suite.respond("GET", "/v5/pins/1234/analytics", 200, `{
+		"all": {
+			"daily_metrics": [
+				{"date": "2021-03-01", "data_status": "READY", "metrics": {"IMPRESSION": 10, "SAVE": 1}},
+				{"date": "2021-03-02", "data_status": "READY", "metrics": {"IMPRESSION": 15}}
+			],
+			"summary_metrics": {"IMPRESSION": 25, "SAVE": 1}
+		}
+	}`)
+
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC)
+	analytics, err := suite.v5Client.Pins.Analytics.Fetch("1234", start, end, []string{"IMPRESSION", "SAVE"}, nil)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 2, len(analytics.DailyMetrics))
+	assert.Equal(suite.T(), "2021-03-02", analytics.DailyMetrics[1].Date.String())
+	assert.Equal(suite.T(), []float64{1, 0}, analytics.Series("SAVE"))
+	assert.Equal(suite.T(), float64(25), analytics.SummaryMetrics["IMPRESSION"])
+
+	request := suite.lastRequest()
+	assert.Equal(suite.T(), "2021-03-01", request.Query.Get("start_date"))
+	assert.Equal(suite.T(), "2021-03-02", request.Query.Get("end_date"))
+	assert.Equal(suite.T(), "IMPRESSION,SAVE", request.Query.Get("metric_types"))
+
+	// Backwards date ranges never reach the server
+	_, err = suite.v5Client.Pins.Analytics.Fetch("1234", end, start, []string{"IMPRESSION"}, nil)
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), 1, suite.requestCount())
+}
+
+// TestV5RunReport tests the asynchronous report flow, from requesting
+// the report to parsing the rows it is downloaded with.
+func (suite *FakeServerTestSuite) TestV5RunReport() {
+	suite.respond("POST", "/v5/ad_accounts/549755885175/reports", 200, `{"report_status": "IN_PROGRESS", "token": "some-token"}`)
+	suite.respond("GET", "/v5/ad_accounts/549755885175/reports", 200, `{
+		"report_status": "FINISHED",
+		"url": "https://reports.example.com/some-report.csv",
+		"size": 100
+	}`)
+	suite.respond("GET", "/some-report.csv", 200, "CAMPAIGN_ID,DATE,SPEND_IN_MICRO_DOLLAR,CAMPAIGN_NAME\n626735565838,2021-03-01,12500000,Spring\n")
+
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	rows, err := suite.v5Client.RunReport("549755885175", start, start, []string{"SPEND_IN_MICRO_DOLLAR"}, &v5.RunReportOptionals{
+		Report: &v5controllers.ReportCreateOptionals{
+			Level:        "CAMPAIGN",
+			ReportFormat: v5models.REPORT_FORMAT_CSV,
+		},
+		PollInterval: time.Millisecond,
+	})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 1, len(rows))
+	assert.Equal(suite.T(), "2021-03-01", rows[0].Date.String())
+	assert.Equal(suite.T(), "626735565838", rows[0].Attributes["CAMPAIGN_ID"])
+	assert.Equal(suite.T(), "626735565838", rows[0].EntityId)
+	assert.Equal(suite.T(), "Spring", rows[0].Attributes["CAMPAIGN_NAME"])
+	assert.Equal(suite.T(), "12.50", rows[0].MicroCurrency("SPEND_IN_MICRO_DOLLAR").String())
+
+	requests := suite.allRequests()
+	assert.Equal(suite.T(), 3, len(requests))
+	var body map[string]interface{}
+	assert.Nil(suite.T(), json.Unmarshal(requests[0].Body, &body))
+	assert.Equal(suite.T(), "CAMPAIGN", body["level"])
+	assert.Equal(suite.T(), "DAY", body["granularity"])
+	assert.Equal(suite.T(), "some-token", requests[1].Query.Get("token"))
+	assert.Equal(suite.T(), "", requests[2].Header.Get("Authorization"))
+
+	// A report that expired can't be resumed
+	suite.respond("GET", "/v5/ad_accounts/549755885175/reports", 200, `{"report_status": "EXPIRED"}`)
+	_, err = suite.v5Client.ResumeReport("549755885175", "some-token", nil)
+	reportError, ok := err.(*v5.ReportError)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), "some-token", reportError.Token)
+	assert.Equal(suite.T(), v5models.REPORT_STATUS_EXPIRED, reportError.ReportStatus)
+
+	// Waiting stops when the Client's context is done
+	suite.respond("GET", "/v5/ad_accounts/549755885175/reports", 200, `{"report_status": "IN_PROGRESS"}`)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = suite.v5Client.WithContext(ctx).ResumeReport("549755885175", "some-token", &v5.RunReportOptionals{
+		PollInterval: time.Minute,
+	})
+	assert.True(suite.T(), errors.Is(err, context.DeadlineExceeded))
+}
+
+// TestV5CatalogsFeeds tests creating a feed, triggering its
+// ingestion, and inspecting the results.
+func (suite *FakeServerTestSuite) TestV5CatalogsFeeds() {
+	suite.respond("POST", "/v5/catalogs/feeds", 201, `{"id": "2680059592705", "name": "Products", "format": "CSV", "status": "ACTIVE"}`)
+	suite.respond("POST", "/v5/catalogs/feeds/2680059592705/ingest", 200, `{}`)
+	suite.respond("GET", "/v5/catalogs/feeds/2680059592705/processing_results", 200, `{
+		"items": [{
+			"id": "5224831246441",
+			"status": "COMPLETED",
+			"product_counts": {"original": 100, "ingested": 98},
+			"validation_details": {"errors": {"PRICE_MISSING": 2}, "warnings": {}}
+		}],
+		"bookmark": null
+	}`)
+
+	feed, err := suite.v5Client.Catalogs.Feeds.Create("Products", "CSV", "https://example.com/products.csv", "en_US", &v5controllers.CatalogFeedCreateOptionals{
+		DefaultCurrency: v5controllers.String("USD"),
+	})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "2680059592705", feed.Id)
+	var body map[string]interface{}
+	assert.Nil(suite.T(), json.Unmarshal(suite.lastRequest().Body, &body))
+	assert.Equal(suite.T(), "USD", body["default_currency"])
+	assert.Equal(suite.T(), "en_US", body["default_locale"])
+
+	assert.Nil(suite.T(), suite.v5Client.Catalogs.Feeds.Ingest(feed.Id))
+
+	results, _, err := suite.v5Client.Catalogs.Feeds.ProcessingResults.Fetch(feed.Id, nil)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(98), (*results)[0].ProductCounts.Ingested)
+	assert.Equal(suite.T(), int64(2), (*results)[0].ValidationDetails.Errors["PRICE_MISSING"])
+}
+
+// TestV5CatalogsItemsBatch tests that items are upserted in batches within
+// the API's limit, and that the outcome of each item is reported.
+func (suite *FakeServerTestSuite) TestV5CatalogsItemsBatch() {
+	suite.respond("POST", "/v5/catalogs/items/batch", 200, `{"batch_id": "595953100599279259-66753b9b", "status": "PROCESSING"}`)
+	suite.respond("GET", "/v5/catalogs/items/batch/595953100599279259-66753b9b", 200, `{
+		"batch_id": "595953100599279259-66753b9b",
+		"status": "COMPLETED",
+		"items": [
+			{"item_id": "sku-1", "status": "SUCCESS", "errors": [], "warnings": []},
+			{"item_id": "sku-2", "status": "FAILURE", "errors": [{"attribute": "price", "code": 102, "message": "Price is missing."}]}
+		]
+	}`)
+
+	items := make([]v5models.CatalogItem, 1500)
+	for i := range items {
+		items[i] = v5models.CatalogItem{
+			ItemId:     "sku-" + strconv.Itoa(i),
+			Attributes: map[string]interface{}{"title": "Product"},
+		}
+	}
+	batches, err := suite.v5Client.Catalogs.Items.Batch.Create("US", "EN", items)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 2, len(*batches))
+
+	requests := suite.allRequests()
+	assert.Equal(suite.T(), 2, len(requests))
+	var body struct {
+		Operation string                 `json:"operation"`
+		Items     []v5models.CatalogItem `json:"items"`
+	}
+	assert.Nil(suite.T(), json.Unmarshal(requests[0].Body, &body))
+	assert.Equal(suite.T(), "UPSERT", body.Operation)
+	assert.Equal(suite.T(), v5models.MAX_ITEMS_PER_BATCH, len(body.Items))
+	assert.Nil(suite.T(), json.Unmarshal(requests[1].Body, &body))
+	assert.Equal(suite.T(), 500, len(body.Items))
+
+	batch, err := suite.v5Client.Catalogs.Items.Batch.Fetch((*batches)[0].BatchId)
+	assert.Nil(suite.T(), err)
+	failures := batch.Failures()
+	assert.Equal(suite.T(), 1, len(failures))
+	assert.Equal(suite.T(), "sku-2", failures[0].ItemId)
+	assert.Equal(suite.T(), "price", failures[0].Errors[0].Attribute)
+}
+
+// TestV5CustomerLists tests that customer list records are normalized,
+// hashed and uploaded in chunks.
+func (suite *FakeServerTestSuite) TestV5CustomerLists() {
+	suite.respond("POST", "/v5/ad_accounts/549755885175/customer_lists", 200, `{"id": "643", "name": "Subscribers", "num_batches": 1}`)
+	suite.respond("PATCH", "/v5/ad_accounts/549755885175/customer_lists/643", 200, `{"id": "643", "name": "Subscribers", "num_batches": 3}`)
+
+	alreadyHashed := v5models.HashSHA256("someone@example.com")
+	records := []string{" Someone@Example.com ", "another@example.com", strings.ToUpper(alreadyHashed), "third@example.com", "fourth@example.com"}
+	customerList, err := suite.v5Client.AdAccounts.CustomerLists.Create("549755885175", "Subscribers", v5models.CUSTOMER_LIST_TYPE_EMAIL, records, &v5controllers.CustomerListRecordsOptionals{
+		ChunkSize: 2,
+	})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), customerList.NumBatches)
+
+	requests := suite.allRequests()
+	assert.Equal(suite.T(), 3, len(requests))
+	var body map[string]interface{}
+	assert.Nil(suite.T(), json.Unmarshal(requests[0].Body, &body))
+	assert.Equal(suite.T(), "EMAIL", body["list_type"])
+	assert.Equal(suite.T(), alreadyHashed+","+v5models.HashSHA256("another@example.com"), body["records"])
+	assert.Nil(suite.T(), json.Unmarshal(requests[1].Body, &body))
+	assert.Equal(suite.T(), "ADD", body["operation_type"])
+	assert.Equal(suite.T(), alreadyHashed+","+v5models.HashSHA256("third@example.com"), body["records"])
+	assert.Nil(suite.T(), json.Unmarshal(requests[2].Body, &body))
+	assert.Equal(suite.T(), v5models.HashSHA256("fourth@example.com"), body["records"])
+
+	// A failed chunk reports how far the upload got
+	suite.respond("PATCH", "/v5/ad_accounts/549755885175/customer_lists/643", 400, `{"code": 1, "message": "Invalid records."}`)
+	_, err = suite.v5Client.AdAccounts.CustomerLists.Remove("549755885175", "643", v5models.CUSTOMER_LIST_TYPE_EMAIL, records, nil)
+	partialUploadError, ok := err.(*v5models.PartialUploadError)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), 0, partialUploadError.Uploaded)
+	assert.Equal(suite.T(), 5, partialUploadError.Total)
+	assert.Nil(suite.T(), json.Unmarshal(suite.lastRequest().Body, &body))
+	assert.Equal(suite.T(), "REMOVE", body["operation_type"])
+
+	// Nothing is sent without records
+	sent := suite.requestCount()
+	_, err = suite.v5Client.AdAccounts.CustomerLists.Create("549755885175", "Subscribers", v5models.CUSTOMER_LIST_TYPE_EMAIL, nil, nil)
+	assert.Equal(suite.T(), v5models.ErrNoCustomerListRecords, err)
+	_, err = suite.v5Client.AdAccounts.CustomerLists.Append("549755885175", "643", v5models.CUSTOMER_LIST_TYPE_EMAIL, []string{}, nil)
+	assert.Equal(suite.T(), v5models.ErrNoCustomerListRecords, err)
+	_, err = suite.v5Client.AdAccounts.CustomerLists.Remove("549755885175", "643", v5models.CUSTOMER_LIST_TYPE_EMAIL, []string{}, nil)
+	assert.Equal(suite.T(), v5models.ErrNoCustomerListRecords, err)
+	assert.Equal(suite.T(), sent, suite.requestCount())
+}
+
+// TestV5Audiences tests creating an audience from a customer list.
+func (suite *FakeServerTestSuite) TestV5Audiences() {
+	suite.respond("POST", "/v5/ad_accounts/549755885175/audiences", 200, `{
+		"id": "2542620905475",
+		"audience_type": "CUSTOMER_LIST",
+		"rule": {"customer_list_id": "643"},
+		"size": 1200
+	}`)
+
+	audience, err := suite.v5Client.AdAccounts.Audiences.Create("549755885175", "Subscribers", v5models.AUDIENCE_TYPE_CUSTOMER_LIST, v5models.AudienceRule{
+		CustomerListId: "643",
+	}, nil)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "643", audience.Rule.CustomerListId)
+	assert.Equal(suite.T(), int64(1200), audience.Size)
+
+	var body map[string]interface{}
+	assert.Nil(suite.T(), json.Unmarshal(suite.lastRequest().Body, &body))
+	assert.Equal(suite.T(), map[string]interface{}{"customer_list_id": "643"}, body["rule"])
+}
+
+// TestV5ConversionsSender tests that conversion events are validated,
+// hashed, sent in batches, and that rejected events are reported.
+func (suite *FakeServerTestSuite) TestV5ConversionsSender() {
+	suite.respond("POST", "/v5/ad_accounts/549755885175/events", 200, `{
+		"num_events_received": 2,
+		"num_events_processed": 1,
+		"events": [
+			{"status": "processed", "error_message": null, "warning_message": null},
+			{"status": "failed", "error_message": "Event time is too old.", "warning_message": null}
+		]
+	}`)
+
+	var failures []v5.ConversionFailure
+	sender := suite.v5Client.NewConversionsSender("549755885175", &v5.ConversionsSenderOptionals{
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+		OnFailure: func(f []v5.ConversionFailure) {
+			failures = append(failures, f...)
+		},
+	})
+	event := func(id string) v5models.ConversionEvent {
+		return v5models.ConversionEvent{
+			EventName:    v5models.CONVERSION_EVENT_CHECKOUT,
+			ActionSource: v5models.ACTION_SOURCE_WEB,
+			EventTime:    time.Now().Unix(),
+			EventId:      id,
+			UserData:     v5models.ConversionUserData{Emails: []string{" Jane@Example.com"}},
+			CustomData:   &v5models.ConversionCustomData{Currency: "USD", Value: "12.50"},
+		}
+	}
+
+	// Invalid events are never queued
+	invalid := event("bad")
+	invalid.EventName = "purchase"
+	assert.NotNil(suite.T(), sender.Send(invalid))
+
+	// The first event is queued, the second fills the batch
+	assert.Nil(suite.T(), sender.Send(event("1")))
+	assert.Equal(suite.T(), 0, suite.requestCount())
+	assert.Nil(suite.T(), sender.Send(event("2")))
+	assert.Equal(suite.T(), 1, suite.requestCount())
+	assert.Len(suite.T(), failures, 1)
+	assert.Equal(suite.T(), "2", failures[0].Event.EventId)
+	assert.Equal(suite.T(), "Event time is too old.", failures[0].Err.Error())
+
+	var body struct {
+		Data []v5models.ConversionEvent `json:"data"`
+	}
+	assert.Nil(suite.T(), json.Unmarshal(suite.lastRequest().Body, &body))
+	assert.Len(suite.T(), body.Data, 2)
+	assert.Equal(suite.T(), []string{v5models.HashSHA256("jane@example.com")}, body.Data[0].UserData.Emails)
+
+	// Close sends what's left, and stops accepting events
+	assert.Nil(suite.T(), sender.Send(event("3")))
+	assert.Nil(suite.T(), sender.Close())
+	assert.Equal(suite.T(), 2, suite.requestCount())
+	assert.Equal(suite.T(), v5.ErrConversionsSenderClosed, sender.Send(event("4")))
+
+	// Close waits for a batch that the flush timer is already sending
+	gated := &gatedTransport{
+		base:    &rewriteTransport{target: suite.targetURL()},
+		gate:    make(chan struct{}),
+		started: make(chan struct{}),
+	}
+	suite.v5Client.SetHttpClient(&http.Client{Transport: gated})
+	sender = suite.v5Client.NewConversionsSender("549755885175", &v5.ConversionsSenderOptionals{
+		FlushInterval: time.Millisecond,
+	})
+	assert.Nil(suite.T(), sender.Send(event("5")))
+	<-gated.started
+	go close(gated.gate)
+	assert.Nil(suite.T(), sender.Close())
+	assert.Equal(suite.T(), 3, suite.requestCount())
+}
+
+// TestV5Keywords tests loading keyword metrics, and trending
+// keywords as a time series in date order.
+func (suite *FakeServerTestSuite) TestV5Keywords() {
+	suite.respond("GET", "/v5/ad_accounts/549755885175/keywords/metrics", 200, `{
+		"all": [{"keyword": "home decor", "metrics": {"avg_cpc_in_micro_currency": 450000, "competition": "HIGH", "monthly_searches": 120000}}]
+	}`)
+	suite.respond("GET", "/v5/trends/keywords/US/top/monthly", 200, `{
+		"trends": [{
+			"keyword": "fall outfits",
+			"pct_growth_wow": 12,
+			"pct_growth_mom": 45,
+			"pct_growth_yoy": -3,
+			"time_series": {"2023-09-10": 80, "2023-08-27": 55, "2023-09-03": 70}
+		}]
+	}`)
+
+	metrics, err := suite.v5Client.AdAccounts.KeywordMetrics.Fetch("549755885175", "US", []string{"home decor", "rugs"})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(120000), (*metrics)[0].Metrics.MonthlySearches)
+	assert.Equal(suite.T(), "home decor,rugs", suite.lastRequest().Query.Get("keywords"))
+
+	trends, err := suite.v5Client.Trends.Keywords.List("US", v5models.TREND_TYPE_MONTHLY, &v5controllers.TrendsKeywordsListOptionals{
+		Limit: 10,
+	})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "10", suite.lastRequest().Query.Get("limit"))
+	series := (*trends)[0].Series()
+	assert.Len(suite.T(), series, 3)
+	assert.Equal(suite.T(), "2023-08-27", series[0].Date.String())
+	assert.Equal(suite.T(), float64(80), series[2].Value)
+}
+
+// TestV5Logger tests that each attempt of a request
+// is logged, at the level of its outcome.
+func (suite *FakeServerTestSuite) TestV5Logger() {
+	suite.respond("GET", "/v5/user_account", 503, `{"code": 1, "message": "Unavailable"}`)
+	buffer := new(bytes.Buffer)
+	logger := slog.New(slog.NewJSONHandler(buffer, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	suite.v5Client.
+		SetLogger(logger, transport.DefaultLogLevels).
+		SetRetryPolicy(transport.RetryPolicy{
+			MaxAttempts: 2,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  time.Millisecond,
+		})
+
+	_, err := suite.v5Client.UserAccount.Fetch()
+	assert.NotNil(suite.T(), err)
+
+	var records []map[string]interface{}
+	decoder := json.NewDecoder(buffer)
+	for decoder.More() {
+		var record map[string]interface{}
+		assert.Nil(suite.T(), decoder.Decode(&record))
+		records = append(records, record)
+	}
+	assert.Len(suite.T(), records, 2)
+	assert.Equal(suite.T(), "ERROR", records[0]["level"])
+	assert.Equal(suite.T(), "/v5/user_account", records[0]["path"])
+	assert.Equal(suite.T(), "UserAccount.Fetch", records[0]["operation"])
+	assert.Equal(suite.T(), float64(503), records[0]["status"])
+	assert.Equal(suite.T(), float64(999), records[0]["ratelimit_remaining"])
+	assert.Nil(suite.T(), records[0]["attempt"])
+	assert.Equal(suite.T(), float64(2), records[1]["attempt"])
+
+	// Identifiers are left out of the path
+	buffer.Reset()
+	suite.respond("GET", "/v5/pins/813744226420795884", 200, `{"id": "813744226420795884"}`)
+	_, err = suite.v5Client.Pins.Fetch("813744226420795884")
+	assert.Nil(suite.T(), err)
+	var record map[string]interface{}
+	assert.Nil(suite.T(), json.Unmarshal(buffer.Bytes(), &record))
+	assert.Equal(suite.T(), "/v5/pins/*", record["path"])
+	assert.Equal(suite.T(), "Pins.Fetch", record["operation"])
+}
+
+// operationRecorder is an instrumentation RoundTripper that records
+// the operation and context of each request it sends.
+type operationRecorder struct {
+	base       http.RoundTripper
+	operations []string
+	contexts   []context.Context
+}
+
+func (or *operationRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	or.operations = append(or.operations, transport.Operation(req.Context()))
+	or.contexts = append(or.contexts, req.Context())
+	return or.base.RoundTrip(req)
+}
+
+// TestV5Instrumentation tests that each request is named after its API
+// call, and sent with the context of the client it was made through.
+func (suite *FakeServerTestSuite) TestV5Instrumentation() {
+	suite.respond("POST", "/v5/pins", 201, `{"id": "813744226420795884"}`)
+	recorder := &operationRecorder{}
+	suite.v5Client.Use(func(base http.RoundTripper) http.RoundTripper {
+		recorder.base = base
+		return recorder
+	})
+
+	type traceKey struct{}
+	ctx := context.WithValue(context.Background(), traceKey{}, "parent-span")
+	_, err := suite.v5Client.WithContext(ctx).Pins.Create("549755885175", v5models.ImageURLSource("https://example.com/image.png"), nil)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []string{"Pins.Create"}, recorder.operations)
+	assert.Equal(suite.T(), "parent-span", recorder.contexts[0].Value(traceKey{}))
+
+	// Requests canceled through the context aren't sent
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = suite.v5Client.WithContext(canceled).Pins.Fetch("813744226420795884")
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), 1, suite.requestCount())
+}
+
+// TestInstrumentation tests that v1 requests are named after their API
+// call too, and sent with the context of the client they were made
+// through, even when it has a Timeout.
+func (suite *FakeServerTestSuite) TestInstrumentation() {
+	suite.respond("GET", "/v1/pins/1/", 200, `{"data": {"id": "1"}}`)
+	suite.respond("GET", "/v1/me/", 200, `{"data": {"id": "2"}}`)
+	recorder := &operationRecorder{}
+	suite.client.
+		SetHttpClient(&http.Client{
+			Timeout:   time.Minute,
+			Transport: &rewriteTransport{target: suite.targetURL()},
+		}).
+		Use(func(base http.RoundTripper) http.RoundTripper {
+			recorder.base = base
+			return recorder
+		})
+
+	type traceKey struct{}
+	ctx := context.WithValue(context.Background(), traceKey{}, "parent-span")
+	_, err := suite.client.WithContext(ctx).Pins.Fetch("1")
+	assert.Nil(suite.T(), err)
+	_, err = suite.client.Me.Fetch()
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []string{"Pins.Fetch", "Me.Fetch"}, recorder.operations)
+	assert.Equal(suite.T(), "parent-span", recorder.contexts[0].Value(traceKey{}))
+	_, hasDeadline := recorder.contexts[0].Deadline()
+	assert.True(suite.T(), hasDeadline)
+	assert.Nil(suite.T(), recorder.contexts[1].Value(traceKey{}))
+
+	// Requests canceled through the context aren't sent
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = suite.client.WithContext(canceled).Pins.Fetch("1")
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), 2, suite.requestCount())
+}
+
+// retryCounter is an instrumentation RoundTripper that
+// counts how many times its requests are retried.
+type retryCounter struct {
+	base    http.RoundTripper
+	retries int
+}
+
+func (rc *retryCounter) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := transport.WithRetryObserver(req.Context(), func(*http.Request, int, *http.Response, error) {
+		rc.retries++
+	})
+	return rc.base.RoundTrip(req.WithContext(ctx))
+}
+
+// TestV5RetryObserver tests that instrumentation layered above
+// the retries can observe each of them.
+func (suite *FakeServerTestSuite) TestV5RetryObserver() {
+	suite.respond("GET", "/v5/user_account", 429, `{"code": 8, "message": "Rate limited"}`)
+	counter := &retryCounter{}
+	suite.v5Client.
+		SetRetryPolicy(transport.RetryPolicy{
+			MaxAttempts: 3,
+			MinBackoff:  time.Millisecond,
+			MaxBackoff:  time.Millisecond,
+		}).
+		Use(func(base http.RoundTripper) http.RoundTripper {
+			counter.base = base
+			return counter
+		})
+
+	_, err := suite.v5Client.UserAccount.Fetch()
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), 2, counter.retries)
+	assert.Equal(suite.T(), 3, suite.requestCount())
+}
+
+// TestMiddleware tests that middleware is layered in order around each
+// request, and is kept when the access token is registered again.
+func (suite *FakeServerTestSuite) TestMiddleware() {
+	suite.respond("GET", "/v1/me/", 200, `{"data": {"id": "1"}}`)
+	tagging := func(name string) transport.Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return transport.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				req = req.Clone(req.Context())
+				req.Header.Add("X-Middleware", name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+	suite.client.
+		Use(tagging("first"), tagging("second")).
+		RegisterAccessToken("new-access-token")
+
+	_, err := suite.client.Me.Fetch()
+	assert.Nil(suite.T(), err)
+
+	request := suite.lastRequest()
+	assert.Equal(suite.T(), []string{"first", "second"}, request.Header["X-Middleware"])
+	assert.Equal(suite.T(), "new-access-token", request.Query.Get("access_token"))
+}
+
+// TestCache tests that the clients cache GET responses per access
+// token, until their resource is updated.
+func (suite *FakeServerTestSuite) TestCache() {
+	board := `{"data": {"id": "1", "name": "Go Pinterest"}}`
+	suite.respond("GET", "/v1/boards/BrandonRRomano/go-pinterest/", 200, board)
+	suite.respond("PATCH", "/v1/boards/BrandonRRomano/go-pinterest/", 200, board)
+	cache := pinterestcache.New(pinterestcache.NewLRUStore(100), &pinterestcache.Config{
+		TTLs: map[string]time.Duration{"boards": time.Hour},
+	})
+	suite.client.SetCache(cache.Wrap)
+
+	// The second fetch is cached
+	for i := 0; i < 2; i++ {
+		fetched, err := suite.client.Boards.Fetch("BrandonRRomano/go-pinterest")
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), "Go Pinterest", fetched.Name)
+	}
+	assert.Equal(suite.T(), 1, suite.requestCount())
+
+	// A client authorized as another user doesn't share the response
+	_, err := pinterest.NewClient().
+		RegisterAccessToken("other-access-token").
+		SetHttpClient(&http.Client{
+			Transport: &rewriteTransport{target: suite.targetURL()},
+		}).
+		SetCache(cache.Wrap).
+		Boards.Fetch("BrandonRRomano/go-pinterest")
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 2, suite.requestCount())
+
+	// Updating the board invalidates it
+	_, err = suite.client.Boards.Update("BrandonRRomano/go-pinterest", nil)
+	assert.Nil(suite.T(), err)
+	_, err = suite.client.Boards.Fetch("BrandonRRomano/go-pinterest")
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 4, suite.requestCount())
+
+	// The v5 client caches too
+	suite.respond("GET", "/v5/boards/549755885175", 200, `{"id": "549755885175", "name": "Recipes"}`)
+	suite.v5Client.SetCache(cache.Wrap)
+	for i := 0; i < 2; i++ {
+		fetched, err := suite.v5Client.Boards.Fetch("549755885175")
+		assert.Nil(suite.T(), err)
+		assert.Equal(suite.T(), "Recipes", fetched.Name)
+	}
+	assert.Equal(suite.T(), 5, suite.requestCount())
+}
+
+// gatedTransport holds every request it sends until its gate is opened,
+// counting them, and signaling them on started if it is set.
+type gatedTransport struct {
+	base    http.RoundTripper
+	gate    chan struct{}
+	started chan struct{}
+	mutex   sync.Mutex
+	sent    int
+}
+
+func (gt *gatedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	gt.mutex.Lock()
+	gt.sent++
+	gt.mutex.Unlock()
+	if gt.started != nil {
+		gt.started <- struct{}{}
+	}
+	<-gt.gate
+	return gt.base.RoundTrip(req)
+}
+
+// TestV5Coalescing tests that identical GET requests in flight at
+// the same time are sent once, and each caller gets its own pin.
+func (suite *FakeServerTestSuite) TestV5Coalescing() {
+	suite.respond("GET", "/v5/pins/813744226420795884", 200, `{"id": "813744226420795884", "title": "Sourdough"}`)
+	gated := &gatedTransport{
+		base: &rewriteTransport{target: suite.targetURL()},
+		gate: make(chan struct{}),
+	}
+	suite.v5Client.
+		SetHttpClient(&http.Client{Transport: gated}).
+		SetCoalescing(true)
+
+	waiting := make(chan struct{}, 5)
+	client := suite.v5Client.WithContext(transport.WithCoalesceObserver(context.Background(), func(*http.Request) {
+		waiting <- struct{}{}
+	}))
+
+	// Hold the first request until the others are waiting for it
+	pins := make([]*v5models.Pin, 5)
+	var wg sync.WaitGroup
+	for i := range pins {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			pin, err := client.Pins.Fetch("813744226420795884")
+			assert.Nil(suite.T(), err)
+			pins[i] = pin
+		}(i)
+	}
+	for i := 0; i < len(pins)-1; i++ {
+		<-waiting
+	}
+	close(gated.gate)
+	wg.Wait()
+
+	assert.Equal(suite.T(), 1, gated.sent)
+	assert.Equal(suite.T(), 1, suite.requestCount())
+	for _, pin := range pins[1:] {
+		assert.Equal(suite.T(), "Sourdough", pin.Title)
+		assert.False(suite.T(), pin == pins[0])
+	}
+}
+
+// TestCoalescing tests that identical v1 requests in flight at the same
+// time are sent once, even when each is made through its own copy of
+// the client.
+func (suite *FakeServerTestSuite) TestCoalescing() {
+	suite.respond("GET", "/v1/pins/813744226420795884/", 200, `{"data": {"id": "813744226420795884", "note": "Sourdough"}}`)
+	gated := &gatedTransport{
+		base: &rewriteTransport{target: suite.targetURL()},
+		gate: make(chan struct{}),
+	}
+	suite.client.
+		SetHttpClient(&http.Client{Transport: gated}).
+		SetCoalescing(true)
+
+	// Hold the first request until the others are waiting for it
+	waiting := make(chan struct{}, 5)
+	ctx := transport.WithCoalesceObserver(context.Background(), func(*http.Request) {
+		waiting <- struct{}{}
+	})
+	pins := make([]*models.Pin, 5)
+	var wg sync.WaitGroup
+	for i := range pins {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			pin, err := suite.client.WithContext(ctx).Pins.Fetch("813744226420795884")
+			assert.Nil(suite.T(), err)
+			pins[i] = pin
+		}(i)
+	}
+	for i := 0; i < len(pins)-1; i++ {
+		<-waiting
+	}
+	close(gated.gate)
+	wg.Wait()
+
+	assert.Equal(suite.T(), 1, gated.sent)
+	assert.Equal(suite.T(), 1, suite.requestCount())
+	for _, pin := range pins {
+		assert.Equal(suite.T(), "Sourdough", pin.Note)
+	}
+}
@@ -0,0 +1,125 @@
+// Package endpoint builds the paths of Pinterest API endpoints.
+//
+// It is shared by the v1 and v5 controllers, so that every identifier that
+// ends up in a path is validated and escaped the same way.
+package endpoint
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/carrot/go-pinterest/models"
+)
+
+// Builder builds the path of an endpoint from its segments,
+// escaping each segment and validating any identifiers along the way.
+//
+// The first invalid identifier is recorded and returned from Build,
+// so that malformed identifiers are rejected before any request is sent.
+type Builder struct {
+	segments   []string
+	noTrailing bool
+	err        error
+}
+
+// New instantiates a new Builder for a v1 endpoint, starting with the
+// provided static segments.  v1 paths end with a trailing slash.
+func New(segments ...string) *Builder {
+	return new(Builder).Segment(segments...)
+}
+
+// NewV5 instantiates a new Builder for a v5 endpoint, starting with the
+// provided static segments.  v5 paths have no trailing slash.
+func NewV5(segments ...string) *Builder {
+	return (&Builder{noTrailing: true}).Segment(segments...)
+}
+
+// Segment appends static segments to the path.
+func (b *Builder) Segment(segments ...string) *Builder {
+	for _, segment := range segments {
+		b.segments = append(b.segments, url.PathEscape(segment))
+	}
+	return b
+}
+
+// Id appends an object id (such as a pin id) to the path.
+func (b *Builder) Id(kind string, id string) *Builder {
+	if !models.IsValidId(id) {
+		b.fail(fmt.Errorf("invalid %s id %q", kind, id))
+		return b
+	}
+	return b.Segment(id)
+}
+
+// KeyKind is a kind of key that isn't numeric (such as a region or
+// a batch id), which can be appended with Key.
+type KeyKind struct {
+	name    string
+	charset *regexp.Regexp
+}
+
+var (
+	// Region is a region code, or several joined with "+", such as "GB+IE".
+	Region = KeyKind{"region", regexp.MustCompile(`^[A-Za-z]{2}(\+[A-Za-z]{2})*$`)}
+
+	// TrendType is a type of trend, such as "monthly".
+	TrendType = KeyKind{"trend type", regexp.MustCompile(`^[a-z_]+$`)}
+
+	// BatchId is the id of a catalogs batch, made of letters,
+	// digits and dashes.
+	BatchId = KeyKind{"batch id", regexp.MustCompile(`^[A-Za-z0-9_-]+$`)}
+)
+
+// Key appends a key of the specified kind to the path.
+func (b *Builder) Key(kind KeyKind, key string) *Builder {
+	// Dot segments would move up the path once it is resolved
+	if key == "." || key == ".." || !kind.charset.MatchString(key) {
+		b.fail(fmt.Errorf("invalid %s %q", kind.name, key))
+		return b
+	}
+	return b.Segment(key)
+}
+
+// User appends a username to the path.
+func (b *Builder) User(username string) *Builder {
+	if !models.IsValidUsername(username) {
+		b.fail(fmt.Errorf("invalid username %q", username))
+		return b
+	}
+	return b.Segment(username)
+}
+
+// Board appends a board_spec to the path.
+func (b *Builder) Board(boardSpec string) *Builder {
+	spec, err := models.ParseBoardSpec(boardSpec)
+	if err != nil {
+		b.fail(err)
+		return b
+	}
+	if spec.Id != "" {
+		return b.Segment(spec.Id)
+	}
+	return b.Segment(spec.Username, spec.Slug)
+}
+
+// Build returns the path, or the first error encountered
+// while building it.
+func (b *Builder) Build() (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+	path := "/" + strings.Join(b.segments, "/")
+	if !b.noTrailing {
+		path += "/"
+	}
+	return path, nil
+}
+
+// fail records err, if no previous error has been recorded.
+func (b *Builder) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
@@ -0,0 +1,87 @@
+package endpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestKey tests that keys are validated against the charset of their
+// kind, and that dot segments are rejected.
+func TestKey(t *testing.T) {
+	path, err := NewV5("trends", "keywords").Key(Region, "GB+IE").
+		Segment("top").Key(TrendType, "monthly").Build()
+	assert.Nil(t, err)
+	assert.Equal(t, "/trends/keywords/GB+IE/top/monthly", path)
+
+	path, err = NewV5("catalogs", "items", "batch").Key(BatchId, "5959531-66753b9b").Build()
+	assert.Nil(t, err)
+	assert.Equal(t, "/catalogs/items/batch/5959531-66753b9b", path)
+
+	invalid := map[string]struct {
+		kind KeyKind
+		key  string
+	}{
+		"empty":        {BatchId, ""},
+		"dot":          {BatchId, "."},
+		"dot dot":      {BatchId, ".."},
+		"slash":        {BatchId, "a/b"},
+		"query":        {BatchId, "a?b=c"},
+		"region":       {Region, "USA"},
+		"trend type":   {TrendType, "../ads"},
+		"space":        {TrendType, "monthly "},
+		"region slash": {Region, "US/../GB"},
+	}
+	for name, c := range invalid {
+		_, err := NewV5("resource").Key(c.kind, c.key).Build()
+		assert.NotNil(t, err, name)
+	}
+}
+
+// TestIdentifiers tests that ids, usernames and board specs are appended
+// escaped, and that those which could escape their resource are rejected.
+func TestIdentifiers(t *testing.T) {
+	path, err := New("users").User("brandon.romano").Segment("boards").Build()
+	assert.Nil(t, err)
+	assert.Equal(t, "/users/brandon.romano/boards/", path)
+
+	path, err = New("boards").Board("BrandonRRomano/Go Pinterest").Build()
+	assert.Nil(t, err)
+	assert.Equal(t, "/boards/BrandonRRomano/go-pinterest/", path)
+
+	path, err = NewV5("pins").Id("pin", "813744226420795884").Build()
+	assert.Nil(t, err)
+	assert.Equal(t, "/pins/813744226420795884", path)
+
+	invalid := map[string]func(b *Builder) *Builder{
+		"empty id":             func(b *Builder) *Builder { return b.Id("pin", "") },
+		"dot id":               func(b *Builder) *Builder { return b.Id("pin", ".") },
+		"dot dot id":           func(b *Builder) *Builder { return b.Id("pin", "..") },
+		"slash id":             func(b *Builder) *Builder { return b.Id("pin", "1/2") },
+		"escaped slash id":     func(b *Builder) *Builder { return b.Id("pin", "1%2F2") },
+		"dot dot id path":      func(b *Builder) *Builder { return b.Id("pin", "192880796521721688/../../me") },
+		"query id":             func(b *Builder) *Builder { return b.Id("pin", "192880796521721688?fields=id") },
+		"empty user":           func(b *Builder) *Builder { return b.User("") },
+		"dot user":             func(b *Builder) *Builder { return b.User(".") },
+		"dot dot user":         func(b *Builder) *Builder { return b.User("..") },
+		"slash user":           func(b *Builder) *Builder { return b.User("a/b") },
+		"escaped slash user":   func(b *Builder) *Builder { return b.User("a%2Fb") },
+		"dot dot user path":    func(b *Builder) *Builder { return b.User("BrandonRRomano/../me") },
+		"fragment user":        func(b *Builder) *Builder { return b.User("hhsnopek#fragment") },
+		"empty board":          func(b *Builder) *Builder { return b.Board("") },
+		"dot board":            func(b *Builder) *Builder { return b.Board(".") },
+		"dot dot board":        func(b *Builder) *Builder { return b.Board("..") },
+		"dot dot slug":         func(b *Builder) *Builder { return b.Board("user/..") },
+		"dot slug":             func(b *Builder) *Builder { return b.Board("user/.") },
+		"dot dot username":     func(b *Builder) *Builder { return b.Board("../x") },
+		"escaped dot dot slug": func(b *Builder) *Builder { return b.Board("user/%2E%2E") },
+		"slash board":          func(b *Builder) *Builder { return b.Board("/") },
+		"too many segments":    func(b *Builder) *Builder { return b.Board("user/board/x") },
+		"escaped slash board":  func(b *Builder) *Builder { return b.Board("%2F") },
+		"escaped slash slug":   func(b *Builder) *Builder { return b.Board("user/%2F") },
+	}
+	for name, build := range invalid {
+		_, err := build(New("resource")).Build()
+		assert.NotNil(t, err, name)
+	}
+}
@@ -0,0 +1,197 @@
+// Package rest is a small JSON REST client, in the spirit of wrecker,
+// that the v5 controllers are built on.
+//
+// Unlike wrecker, it sends JSON request bodies, which the v5 API requires.
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/carrot/go-pinterest/models"
+	"github.com/carrot/go-pinterest/transport"
+)
+
+// Client sends requests to a REST API.
+type Client struct {
+	BaseURL    string
+	HttpClient *http.Client
+
+	// Context, if set, is the context that requests are sent with.
+	Context context.Context
+
+	// RetainRaw, if set, populates the Raw field of each decoded model.
+	RetainRaw bool
+}
+
+// Request is a request being built against a Client.
+type Request struct {
+	client      *Client
+	method      string
+	path        string
+	urlParams   url.Values
+	header      http.Header
+	body        func() (io.Reader, error)
+	contentType string
+	into        interface{}
+	operation   string
+}
+
+// Get starts building a GET request to the path.
+func (c *Client) Get(path string) *Request {
+	return c.newRequest("GET", path)
+}
+
+// Post starts building a POST request to the path.
+func (c *Client) Post(path string) *Request {
+	return c.newRequest("POST", path)
+}
+
+// Patch starts building a PATCH request to the path.
+func (c *Client) Patch(path string) *Request {
+	return c.newRequest("PATCH", path)
+}
+
+// Delete starts building a DELETE request to the path.
+func (c *Client) Delete(path string) *Request {
+	return c.newRequest("DELETE", path)
+}
+
+// newRequest starts building a request.
+func (c *Client) newRequest(method, path string) *Request {
+	return &Request{
+		client:    c,
+		method:    method,
+		path:      path,
+		urlParams: url.Values{},
+		header:    http.Header{},
+	}
+}
+
+// URLParam adds a query string parameter to the request.
+func (r *Request) URLParam(key, value string) *Request {
+	r.urlParams.Add(key, value)
+	return r
+}
+
+// Header sets a header on the request.
+func (r *Request) Header(key, value string) *Request {
+	r.header.Set(key, value)
+	return r
+}
+
+// JSONBody sends body encoded as JSON.
+func (r *Request) JSONBody(body interface{}) *Request {
+	r.contentType = "application/json"
+	r.body = func() (io.Reader, error) {
+		encoded, err := json.Marshal(body)
+		return bytes.NewReader(encoded), err
+	}
+	return r
+}
+
+// FormBody sends form as an application/x-www-form-urlencoded body.
+func (r *Request) FormBody(form url.Values) *Request {
+	r.contentType = "application/x-www-form-urlencoded"
+	r.body = func() (io.Reader, error) {
+		return strings.NewReader(form.Encode()), nil
+	}
+	return r
+}
+
+// Operation names the API call that the request is sent for, such as
+// "Pins.Create", so it can be traced.
+func (r *Request) Operation(name string) *Request {
+	r.operation = name
+	return r
+}
+
+// Into decodes the JSON response body into v, for both successful
+// and failed responses.
+func (r *Request) Into(v interface{}) *Request {
+	r.into = v
+	return r
+}
+
+// URL returns the full URL the request will be sent to.
+func (r *Request) URL() string {
+	u := r.client.BaseURL + r.path
+	if len(r.urlParams) > 0 {
+		u += "?" + r.urlParams.Encode()
+	}
+	return u
+}
+
+// Execute sends the request.
+//
+// Unlike wrecker, a response with a non 2xx status code is not an error,
+// and should be checked by the caller.  The returned response's body
+// has already been read and closed.
+func (r *Request) Execute() (*http.Response, error) {
+	// Build request
+	var body io.Reader
+	if r.body != nil {
+		var err error
+		if body, err = r.body(); err != nil {
+			return nil, err
+		}
+	}
+	req, err := http.NewRequest(r.method, r.URL(), body)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range r.header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	if r.contentType != "" {
+		req.Header.Set("Content-Type", r.contentType)
+	}
+
+	// Send request
+	resp, err := r.client.Do(req, r.operation)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Decode response
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp, err
+	}
+	if r.into != nil && len(bytes.TrimSpace(data)) > 0 {
+		into := r.into
+		if r.client.RetainRaw {
+			into = models.RetainingRaw(into)
+		}
+		if err := json.Unmarshal(data, into); err != nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+// Do sends a request that was built outside of the Client (such as one
+// to an absolute URL) with the Client's http.Client and context, naming
+// the API call that it is sent for.
+func (c *Client) Do(req *http.Request, operation string) (*http.Response, error) {
+	ctx := req.Context()
+	if c.Context != nil {
+		ctx = c.Context
+	}
+	if operation != "" {
+		ctx = transport.WithOperation(ctx, operation)
+	}
+	httpClient := c.HttpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return httpClient.Do(req.WithContext(ctx))
+}
@@ -8,4 +8,5 @@ type AccessToken struct {
 	Scope            []string `json:"scope"`
 	ErrorDescription string   `json:"error_description"`
 	Error            string   `json:"error"`
+	Raw              Raw      `json:"-"`
 }
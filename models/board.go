@@ -18,10 +18,12 @@ type Board struct {
 	Counts      BoardCounts  `json:"counts"`
 	Image       Images       `json:"image"`
 	Privacy     string       `json:"privacy"`
+	Raw         Raw          `json:"-"`
 }
 
 type BoardCounts struct {
 	Pins          int32 `json:"pins"`
 	Collaborators int32 `json:"collaborators"`
 	Followers     int32 `json:"followers"`
+	Raw           Raw   `json:"-"`
 }
@@ -0,0 +1,12 @@
+package models
+
+const BOARD_SECTION_FIELDS = "id,title,pin_count"
+
+// BoardSection is a struct that represents an individual section
+// of a board from the Pinterest API.
+type BoardSection struct {
+	Id       string `json:"id"`
+	Title    string `json:"title"`
+	PinCount int32  `json:"pin_count"`
+	Raw      Raw    `json:"-"`
+}
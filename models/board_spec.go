@@ -0,0 +1,126 @@
+package models
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// BoardSpec is a struct that represents a reference to a board, as
+// accepted by the Pinterest API's <board_spec:board> parameters.
+//
+// A BoardSpec either references a board by its owner and slug
+// (username/board-slug), or by its numeric Id.
+type BoardSpec struct {
+	Username string
+	Slug     string
+	Id       string
+}
+
+// ParseBoardSpec parses a board reference into a BoardSpec.
+//
+// It accepts a board_spec (username/board-slug), a board URL
+// (https://www.pinterest.com/username/board-slug/) or a numeric board id.
+// Surrounding whitespace and slashes are ignored, and the slug is normalized
+// with NormalizeBoardSlug.
+func ParseBoardSpec(s string) (BoardSpec, error) {
+	trimmed := strings.Trim(strings.TrimSpace(s), "/")
+	if trimmed == "" {
+		return BoardSpec{}, fmt.Errorf("invalid board spec %q: empty", s)
+	}
+
+	// Numeric Id
+	if IsValidId(trimmed) {
+		return BoardSpec{Id: trimmed}, nil
+	}
+
+	// Board URL
+	path := trimmed
+	if isPinterestURL(trimmed) {
+		raw := trimmed
+		if !strings.Contains(raw, "://") {
+			raw = "https://" + raw
+		}
+		u, err := url.Parse(raw)
+		if err != nil {
+			return BoardSpec{}, fmt.Errorf("invalid board spec %q: %v", s, err)
+		}
+		path = strings.Trim(u.Path, "/")
+	}
+
+	// username/board-slug
+	segments := strings.Split(path, "/")
+	if len(segments) != 2 {
+		return BoardSpec{}, fmt.Errorf("invalid board spec %q: expected username/board-slug", s)
+	}
+	username := strings.TrimPrefix(strings.TrimSpace(segments[0]), "@")
+	slug := NormalizeBoardSlug(segments[1])
+	if !IsValidUsername(username) {
+		return BoardSpec{}, fmt.Errorf("invalid board spec %q: bad username %q", s, username)
+	}
+	if slug == "" {
+		return BoardSpec{}, fmt.Errorf("invalid board spec %q: empty board slug", s)
+	}
+	if isDotSegment(slug) || strings.Contains(slug, "/") {
+		return BoardSpec{}, fmt.Errorf("invalid board spec %q: bad board slug %q", s, slug)
+	}
+	return BoardSpec{Username: username, Slug: slug}, nil
+}
+
+// String returns the BoardSpec in the form the Pinterest API expects
+// in request parameters: either username/board-slug or the board id.
+func (bs BoardSpec) String() string {
+	if bs.Id != "" {
+		return bs.Id
+	}
+	return bs.Username + "/" + bs.Slug
+}
+
+// Path returns the BoardSpec escaped for use in a URL path.
+func (bs BoardSpec) Path() string {
+	if bs.Id != "" {
+		return url.PathEscape(bs.Id)
+	}
+	return url.PathEscape(bs.Username) + "/" + url.PathEscape(bs.Slug)
+}
+
+// NormalizeBoardSlug converts a board name or slug into the form that
+// Pinterest uses in board URLs: lowercase, with runs of whitespace and
+// underscores collapsed into single hyphens.
+func NormalizeBoardSlug(slug string) string {
+	if unescaped, err := url.PathUnescape(slug); err == nil {
+		slug = unescaped
+	}
+
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(strings.TrimSpace(slug)) {
+		if unicode.IsSpace(r) || r == '_' || r == '-' {
+			pendingHyphen = true
+			continue
+		}
+		if pendingHyphen && b.Len() > 0 {
+			b.WriteRune('-')
+		}
+		pendingHyphen = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// isPinterestURL returns true if s looks like a pinterest.com
+// (or country specific pinterest domain) URL.
+func isPinterestURL(s string) bool {
+	host := s
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	if i := strings.Index(host, "/"); i >= 0 {
+		host = host[:i]
+	}
+	host = strings.ToLower(host)
+	return host == "pinterest.com" ||
+		strings.HasPrefix(host, "pinterest.") ||
+		strings.Contains(host, ".pinterest.")
+}
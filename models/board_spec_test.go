@@ -0,0 +1,31 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/carrot/go-pinterest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseBoardSpec tests that board specs, board URLs and board ids
+// are all parsed into a BoardSpec, and that malformed ones are rejected.
+func TestParseBoardSpec(t *testing.T) {
+	cases := map[string]string{
+		"BrandonRRomano/go-pinterest":                            "BrandonRRomano/go-pinterest",
+		" /BrandonRRomano/go-pinterest/ ":                        "BrandonRRomano/go-pinterest",
+		"BrandonRRomano/Go Pinterest":                            "BrandonRRomano/go-pinterest",
+		"https://www.pinterest.com/BrandonRRomano/go-pinterest/": "BrandonRRomano/go-pinterest",
+		"pinterest.co.uk/BrandonRRomano/go-pinterest":            "BrandonRRomano/go-pinterest",
+		"192880865240826744":                                     "192880865240826744",
+	}
+	for input, expected := range cases {
+		spec, err := models.ParseBoardSpec(input)
+		assert.Nil(t, err, input)
+		assert.Equal(t, expected, spec.String(), input)
+	}
+
+	for _, input := range []string{"", "/", "BrandonRRomano", "a/b/c", "Brandon Romano/go-pinterest", "BrandonRRomano/ "} {
+		_, err := models.ParseBoardSpec(input)
+		assert.NotNil(t, err, input)
+	}
+}
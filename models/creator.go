@@ -5,4 +5,5 @@ type Creator struct {
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
 	Id        string `json:"id"`
+	Raw       Raw    `json:"-"`
 }
@@ -0,0 +1,32 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/carrot/go-pinterest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPinDecode tests that a pin's media is decoded from its media field.
+func TestPinDecode(t *testing.T) {
+	var pin models.Pin
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"id": "1",
+		"media": {"type": "video"}
+	}`), &pin))
+	assert.Equal(t, "video", pin.Media.Type)
+}
+
+// TestUserDecode tests that a user's url and account type are decoded
+// from their own fields.
+func TestUserDecode(t *testing.T) {
+	var user models.User
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"id": "1",
+		"account_type": "business",
+		"url": "https://www.pinterest.com/brandonrromano/"
+	}`), &user))
+	assert.Equal(t, "business", user.AccountType)
+	assert.Equal(t, "https://www.pinterest.com/brandonrromano/", user.Url)
+}
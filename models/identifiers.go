@@ -0,0 +1,38 @@
+package models
+
+import (
+	"unicode"
+)
+
+// IsValidId returns true if s is a well formed Pinterest object id.
+// Pinterest ids are made up entirely of digits.
+func IsValidId(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// IsValidUsername returns true if s is a plausible Pinterest username.
+func IsValidUsername(s string) bool {
+	if s == "" || isDotSegment(s) {
+		return false
+	}
+	for _, r := range s {
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// isDotSegment returns true if s is "." or "..", which would
+// move up the path of a URL once it is resolved.
+func isDotSegment(s string) bool {
+	return s == "." || s == ".."
+}
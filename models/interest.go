@@ -7,4 +7,5 @@ const INTEREST_FIELDS = "id,name"
 type Interest struct {
 	Id   string `json:"id"`
 	Name string `json:"name"`
+	Raw  Raw    `json:"-"`
 }
@@ -14,25 +14,29 @@ type Pin struct {
 	Note         string       `json:"note"`
 	Color        string       `json:"color"`
 	Counts       PinCounts    `json:"counts"`
-	Media        Media        `json:"json:"media"`
+	Media        Media        `json:"media"`
 	OriginalLink string       `json:"original_link"`
 	Attribution  Attribution  `json:"attribution"`
 	Image        PinImage     `json:"image"`
 	Metadata     PinMetadata  `json:"metadata"`
+	Raw          Raw          `json:"-"`
 }
 
 type PinImage struct {
 	Original Image `json:"original"`
+	Raw      Raw   `json:"-"`
 }
 
 type PinCounts struct {
 	Likes    int32 `json:"likes"`
 	Comments int32 `json:"comments"`
 	Repins   int32 `json:"repins"`
+	Raw      Raw   `json:"-"`
 }
 
 type Media struct {
 	Type string `json:"type"`
+	Raw  Raw    `json:"-"`
 }
 
 type Attribution struct {
@@ -43,4 +47,5 @@ type Attribution struct {
 	ProviderFaviconUrl string `json:"provider_favicon_url"`
 	AuthorUrl          string `json:"author_url"`
 	ProviderName       string `json:"provider_name"`
+	Raw                Raw    `json:"-"`
 }
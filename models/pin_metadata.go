@@ -10,10 +10,12 @@ type PinMetadata struct {
 	Place   Place   `json:"place"`
 	Movie   Movie   `json:"movie"`
 	Product Product `json:"product"`
+	Raw     Raw     `json:"-"`
 }
 
 type MetadataPerson struct {
 	Name string `json:"name"`
+	Raw  Raw    `json:"-"`
 }
 
 // ========== Meta: Article ==========
@@ -23,6 +25,7 @@ type Article struct {
 	Description string           `json:"description"`
 	Name        string           `json:"name"`
 	Authors     []MetadataPerson `json:"authors"`
+	Raw         Raw              `json:"-"`
 }
 
 // ========== Meta: Link ==========
@@ -33,6 +36,7 @@ type Link struct {
 	SiteName    string `json:"site_name"`
 	Description string `json:"description"`
 	Favicon     string `json:"favicon"`
+	Raw         Raw    `json:"-"`
 }
 
 // ========== Meta: Place ==========
@@ -48,6 +52,7 @@ type Place struct {
 	Street     string  `json:"street"`
 	PostalCode string  `json:"postal_code"`
 	Latitude   float32 `json:"latitude"`
+	Raw        Raw     `json:"-"`
 }
 
 // ========== Meta: Movie ==========
@@ -58,6 +63,7 @@ type Movie struct {
 	Actors      []MetadataPerson `json:"actors"`
 	Name        string           `json:"name"`
 	PublishedAt iso8601.Time     `json:"published_at"`
+	Raw         Raw              `json:"-"`
 }
 
 // ========== Meta: Product ==========
@@ -65,11 +71,13 @@ type Movie struct {
 type Product struct {
 	Name  string       `json:"name"`
 	Offer ProductOffer `json:"offer"`
+	Raw   Raw          `json:"-"`
 }
 
 type ProductOffer struct {
 	Price   string `json:"price"`
 	InStock bool   `json:"in_stock"`
+	Raw     Raw    `json:"-"`
 }
 
 // ========== Meta: Recipe ==========
@@ -78,19 +86,23 @@ type Recipe struct {
 	Servings    RecipeServings   `json:"servings"`
 	Name        string           `json:"name"`
 	Ingredients []RecipeCategory `json:"ingredients"`
+	Raw         Raw              `json:"-"`
 }
 
 type RecipeServings struct {
 	Serves  string `json:"serves"`
 	Summary string `json:"summary"`
+	Raw     Raw    `json:"-"`
 }
 
 type RecipeCategory struct {
 	Category    string             `json:"category"`
 	Ingredients []RecipeIngredient `json:"ingredients"`
+	Raw         Raw                `json:"-"`
 }
 
 type RecipeIngredient struct {
 	Amount string `json:"amount"`
 	Name   string `json:"name"`
+	Raw    Raw    `json:"-"`
 }
@@ -11,6 +11,7 @@ import (
 // non 200 responses from the API.
 type PinterestError struct {
 	StatusCode int    `json:"status_code"`
+	Code       int    `json:"code,omitempty"`
 	Message    string `json:"message"`
 	Limit      TypeRatelimit
 }
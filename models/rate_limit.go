@@ -3,7 +3,6 @@ package models
 import (
 	"net/http"
 	"strconv"
-	"fmt"
 )
 
 type TypeRatelimit struct {
@@ -12,11 +11,12 @@ type TypeRatelimit struct {
 	Refresh   int
 }
 
+// GetLimit returns the value of a rate limit header,
+// or 0 if it is missing or can't be parsed.
 func GetLimit(httpResp *http.Response, key string) int {
 	if val, ok := httpResp.Header[key]; ok {
 		rateLimit, err := strconv.Atoi(val[0])
 		if err != nil {
-			fmt.Println(err)
 			return 0
 		}
 		return rateLimit
@@ -25,10 +25,9 @@ func GetLimit(httpResp *http.Response, key string) int {
 }
 
 func GetRatelimit(httpResp *http.Response) TypeRatelimit {
-	return TypeRatelimit {
+	return TypeRatelimit{
 		Remaining: GetLimit(httpResp, "X-Ratelimit-Remaining"),
-		Limit: GetLimit(httpResp, "X-Ratelimit-Limit"),
-		Refresh: GetLimit(httpResp, "X-Ratelimit-Refresh"),
+		Limit:     GetLimit(httpResp, "X-Ratelimit-Limit"),
+		Refresh:   GetLimit(httpResp, "X-Ratelimit-Refresh"),
 	}
 }
-
@@ -0,0 +1,200 @@
+package models
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// knownFields caches the JSON keys that each model type decodes.
+var knownFields sync.Map
+
+// rawType is the type of the Raw field of each model.
+var rawType = reflect.TypeOf(Raw{})
+
+// Raw is a struct that holds the raw JSON a model was decoded from.
+//
+// Raw is only populated by a Client that was set to retain it, with its
+// SetRetainRaw method.
+type Raw struct {
+	// JSON is the exact JSON payload the model was decoded from.
+	JSON json.RawMessage
+
+	// Unknown holds each field in the payload that
+	// the model does not have a struct field for.
+	Unknown map[string]json.RawMessage
+}
+
+// RawPopulator is implemented by responses (such as the v5 Response)
+// that decode their models from somewhere other than a field of their
+// own, so that their models' Raw fields can be populated.
+type RawPopulator interface {
+	PopulateRaw(data []byte)
+}
+
+// RetainingRaw returns a json.Unmarshaler that decodes into v, and then
+// populates the Raw field of v and of every model nested in it.
+//
+// It is exported so the clients and the v5 models can share the same
+// behavior, and is not meant to be called directly.
+func RetainingRaw(v interface{}) json.Unmarshaler {
+	return &retainingRaw{v: v}
+}
+
+// retainingRaw is a json.Unmarshaler that retains the raw JSON of the
+// models it decodes.
+type retainingRaw struct {
+	v interface{}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *retainingRaw) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, r.v); err != nil {
+		return err
+	}
+	PopulateRaw(data, r.v)
+	return nil
+}
+
+// PopulateRaw populates the Raw field of v and of every model nested in
+// it from data, the JSON that v was decoded from.
+//
+// It is exported so the v5 models can share the same behavior,
+// and is not meant to be called directly.
+func PopulateRaw(data []byte, v interface{}) {
+	if v != nil {
+		populateRaw(data, reflect.ValueOf(v))
+	}
+}
+
+// populateRaw walks v alongside data, the JSON it was decoded from.
+func populateRaw(data []byte, v reflect.Value) {
+	if v.CanAddr() {
+		if populator, ok := v.Addr().Interface().(RawPopulator); ok {
+			populator.PopulateRaw(data)
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			populateRaw(data, v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return
+		}
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			populateRaw(items[i], v.Index(i))
+		}
+	case reflect.Map:
+		populateMapRaw(data, v)
+	case reflect.Struct:
+		populateStructRaw(data, v)
+	}
+}
+
+// populateMapRaw walks each value of the map v, which are
+// copied as map values can't be modified in place.
+func populateMapRaw(data []byte, v reflect.Value) {
+	if v.IsNil() || v.Type().Key().Kind() != reflect.String {
+		return
+	}
+	items := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &items); err != nil {
+		return
+	}
+	for key, item := range items {
+		mapKey := reflect.ValueOf(key).Convert(v.Type().Key())
+		value := v.MapIndex(mapKey)
+		if !value.IsValid() {
+			continue
+		}
+		populated := reflect.New(value.Type()).Elem()
+		populated.Set(value)
+		populateRaw(item, populated)
+		v.SetMapIndex(mapKey, populated)
+	}
+}
+
+// populateStructRaw populates the Raw field of the struct v,
+// and walks each of its fields.
+func populateStructRaw(data []byte, v reflect.Value) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		// Not an object (e.g. null), so there is nothing to populate
+		return
+	}
+	byName := make(map[string]json.RawMessage, len(fields))
+	for key, value := range fields {
+		byName[strings.ToLower(key)] = value
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" || !v.Field(i).CanSet() {
+			continue
+		}
+		if field.Type == rawType && field.Name == "Raw" {
+			v.Field(i).Set(reflect.ValueOf(newRaw(data, fields, t)))
+			continue
+		}
+		if value, ok := byName[jsonName(field)]; ok {
+			populateRaw(value, v.Field(i))
+		}
+	}
+}
+
+// newRaw returns the Raw of a struct of type t decoded from data, whose
+// fields have already been split.
+func newRaw(data []byte, fields map[string]json.RawMessage, t reflect.Type) Raw {
+	// Keep a copy of the payload, as data may be reused by the decoder
+	raw := Raw{JSON: append(json.RawMessage(nil), data...)}
+
+	// Find the fields we don't know about
+	known := knownFieldsOf(t)
+	for key, value := range fields {
+		if _, ok := known[strings.ToLower(key)]; !ok {
+			if raw.Unknown == nil {
+				raw.Unknown = map[string]json.RawMessage{}
+			}
+			raw.Unknown[key] = value
+		}
+	}
+	return raw
+}
+
+// jsonName returns the (lowercased) JSON key that encoding/json decodes
+// into field, or "-" if it decodes none.
+func jsonName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" {
+		name = field.Name
+	}
+	return strings.ToLower(name)
+}
+
+// knownFieldsOf returns the set of (lowercased) JSON keys that
+// encoding/json would decode into a struct of type t.
+func knownFieldsOf(t reflect.Type) map[string]struct{} {
+	if cached, ok := knownFields.Load(t); ok {
+		return cached.(map[string]struct{})
+	}
+
+	known := map[string]struct{}{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		if name := jsonName(field); name != "-" {
+			known[name] = struct{}{}
+		}
+	}
+	knownFields.Store(t, known)
+	return known
+}
@@ -0,0 +1,34 @@
+package models_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/carrot/go-pinterest/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRetainRaw tests that models keep their raw JSON and any unknown
+// fields only when they are decoded retaining it.
+func TestRetainRaw(t *testing.T) {
+	payload := []byte(`{"data": {"id": "1", "note": "Cat", "brand_new": [1, 2], "board": {"id": "2", "section": "a"}}}`)
+
+	// Disabled by default
+	resp := &models.Response{Data: new(models.Pin)}
+	assert.Nil(t, json.Unmarshal(payload, resp))
+	pin := resp.Data.(*models.Pin)
+	assert.Equal(t, "Cat", pin.Note)
+	assert.Equal(t, 0, len(pin.Raw.JSON))
+	assert.Equal(t, 0, len(pin.Raw.Unknown))
+
+	// Enabled
+	resp = &models.Response{Data: new(models.Pin)}
+	assert.Nil(t, json.Unmarshal(payload, models.RetainingRaw(resp)))
+	pin = resp.Data.(*models.Pin)
+	assert.Equal(t, "Cat", pin.Note)
+	assert.Equal(t, "2", pin.Board.Id)
+	assert.Equal(t, 1, len(pin.Raw.Unknown))
+	assert.Equal(t, "[1, 2]", string(pin.Raw.Unknown["brand_new"]))
+	assert.Equal(t, `"a"`, string(pin.Board.Raw.Unknown["section"]))
+	assert.Equal(t, `{"id": "2", "section": "a"}`, string(pin.Board.Raw.JSON))
+}
@@ -15,10 +15,11 @@ type User struct {
 	LastName    string       `json:"last_name"`
 	Bio         string       `json:"bio"`
 	AccountType string       `json:"account_type"`
-	Url         string       `json:"account_type"`
+	Url         string       `json:"url"`
 	CreatedAt   iso8601.Time `json:"created_at"`
 	Counts      UserCounts   `json:"counts"`
 	Image       Images       `json:"image"`
+	Raw         Raw          `json:"-"`
 }
 
 type UserCounts struct {
@@ -27,4 +28,5 @@ type UserCounts struct {
 	Followers int32 `json:"followers"`
 	Boards    int32 `json:"boards"`
 	Likes     int32 `json:"likes"`
+	Raw       Raw   `json:"-"`
 }
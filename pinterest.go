@@ -1,11 +1,15 @@
 package pinterest
 
 import (
+	"context"
+	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/BrandonRomano/wrecker"
 	"github.com/carrot/go-pinterest/controllers"
+	"github.com/carrot/go-pinterest/models"
+	"github.com/carrot/go-pinterest/transport"
 )
 
 // Client is an API client that connects you with the
@@ -24,45 +28,224 @@ type Client struct {
 	Pins          *controllers.PinsController
 	Me            *controllers.MeController
 	wreckerClient *wrecker.Wrecker
+	httpClient    *http.Client
+	sharedClient  *http.Client
+	retryPolicy   transport.RetryPolicy
+	logger        *slog.Logger
+	logLevels     transport.LogLevels
+	accessToken   string
+	middleware    []transport.Middleware
+	coalescing    bool
+	cache         transport.Middleware
+	context       context.Context
 }
 
 // NewClient generates a new instance of a Client, which will
 // allow you to interact with the Pinterest API.
 func NewClient() *Client {
 	// Build Wrecker client
+	httpClient := &http.Client{
+		Timeout: 10 * time.Second,
+	}
 	wc := &wrecker.Wrecker{
-		BaseURL: "https://api.pinterest.com/v1",
-		HttpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		BaseURL:            "https://api.pinterest.com/v1",
+		HttpClient:         httpClient,
 		DefaultContentType: "application/json",
 		RequestInterceptor: nil,
 	}
 
 	// Build Pinterest client
-	return &Client{
+	pc := &Client{
 		wreckerClient: wc,
-		OAuth:         controllers.NewOAuthController(wc),
-		Users:         controllers.NewUsersController(wc),
-		Boards:        controllers.NewBoardsController(wc),
-		Pins:          controllers.NewPinsController(wc),
-		Me:            controllers.NewMeController(wc),
+		httpClient:    httpClient,
 	}
+	pc.buildHttpClient()
+	pc.buildControllers()
+	return pc
+}
+
+// WithContext returns a copy of the Client whose requests are sent with
+// ctx, so that they can be canceled, and traced as part of the caller's
+// work.  The copy has the Client's settings as they are when it is made,
+// and is meant to be used for a single unit of work:
+//
+//	pin, err := client.WithContext(ctx).Pins.Fetch("1234")
+//
+// Copies share the Client's RoundTrippers, so that identical requests
+// of different copies are coalesced (see SetCoalescing).
+func (pc *Client) WithContext(ctx context.Context) *Client {
+	wc := *pc.wreckerClient
+
+	scoped := *pc
+	scoped.wreckerClient = &wc
+	scoped.context = ctx
+	scoped.scopeHttpClient()
+	scoped.buildControllers()
+	return &scoped
+}
+
+// buildControllers instantiates the Client's controllers
+// over its wrecker client.
+func (pc *Client) buildControllers() {
+	wc := pc.wreckerClient
+	pc.OAuth = controllers.NewOAuthController(wc)
+	pc.Users = controllers.NewUsersController(wc)
+	pc.Boards = controllers.NewBoardsController(wc)
+	pc.Pins = controllers.NewPinsController(wc)
+	pc.Me = controllers.NewMeController(wc)
 }
 
 // RegisterAccessToken registers an AccessToken on an existing Client.
 // All following requests made with the Client will be authorized with
 // the specified AccessToken.
 func (pc *Client) RegisterAccessToken(accessToken string) *Client {
-	pc.wreckerClient.RequestInterceptor = func(req *wrecker.Request) error {
-		req.URLParam("access_token", accessToken)
-		return nil
-	}
+	pc.accessToken = accessToken
+	pc.buildHttpClient()
 	return pc
 }
 
 // SetHttpClient sets the underlying http.Client that runs all API requests
 func (pc *Client) SetHttpClient(client *http.Client) *Client {
-	pc.wreckerClient.HttpClient = client
+	pc.httpClient = client
+	pc.buildHttpClient()
+	return pc
+}
+
+// SetRetryPolicy enables retrying requests that were rate limited or
+// failed with a transient error, following the specified RetryPolicy.
+// Retries are disabled by default.
+//
+// While retries are enabled, the Timeout of the http.Client set with
+// SetHttpClient applies to each attempt rather than to the request as a
+// whole, so that waiting to retry doesn't use it up.  Use a context (see
+// WithContext) to limit how long a request may take including its retries.
+func (pc *Client) SetRetryPolicy(policy transport.RetryPolicy) *Client {
+	pc.retryPolicy = policy
+	pc.buildHttpClient()
+	return pc
+}
+
+// SetLogger logs every request the Client sends to logger, with its
+// method, operation, path, status, latency, the rate limit remaining and
+// its attempt number, at the levels of its outcome (such as
+// transport.DefaultLogLevels).  Identifiers such as usernames are left out
+// of the logged path (see transport.RedactPath).  Requests aren't logged
+// by default.
+func (pc *Client) SetLogger(logger *slog.Logger, levels transport.LogLevels) *Client {
+	pc.logger = logger
+	pc.logLevels = levels
+	pc.buildHttpClient()
+	return pc
+}
+
+// SetCoalescing enables sending identical GET requests only once while one
+// is in flight, so that goroutines fetching the same pin or board at the
+// same time share a single request (and its rate limit cost).  Each caller
+// still decodes its own copy of the model.  Coalescing is disabled by
+// default.
+func (pc *Client) SetCoalescing(enabled bool) *Client {
+	pc.coalescing = enabled
+	pc.buildHttpClient()
+	return pc
+}
+
+// SetCache caches the responses of the Client's GET requests with cache,
+// such as the Wrap method of a pinterestcache.Cache.  Unlike middleware
+// added with Use, cache is layered below authorization, so that it can
+// tell apart the responses of different users.  Pass nil to stop caching.
+func (pc *Client) SetCache(cache transport.Middleware) *Client {
+	pc.cache = cache
+	pc.buildHttpClient()
 	return pc
 }
+
+// SetRetainRaw toggles whether the models the Client decodes retain
+// their raw JSON payload, and any fields that the model does not
+// recognize, in their Raw field.
+//
+// This is disabled by default, as it roughly doubles the memory used
+// by each decoded model.
+func (pc *Client) SetRetainRaw(retain bool) *Client {
+	pc.wreckerClient.RequestInterceptor = nil
+	if retain {
+		pc.wreckerClient.RequestInterceptor = retainRaw
+	}
+	return pc
+}
+
+// Use adds middleware to the Client, which wraps every request it sends
+// (see transport.Middleware), such as to add headers, audit requests or
+// measure them (see the pinterestotel and pinterestprom packages).
+//
+// Middleware is layered in the order it is added, above the Client's own
+// RoundTrippers: it sees each request once, however many times it is
+// retried, and before it is authorized.
+func (pc *Client) Use(middleware ...transport.Middleware) *Client {
+	pc.middleware = append(pc.middleware, middleware...)
+	pc.buildHttpClient()
+	return pc
+}
+
+// retainRaw is a wrecker RequestInterceptor that has the response of
+// each request decoded retaining its raw JSON.
+func retainRaw(request *wrecker.Request) error {
+	if request.Response != nil {
+		request.Response = models.RetainingRaw(request.Response)
+	}
+	return nil
+}
+
+// buildHttpClient layers the Client's RoundTrippers over the
+// http.Client set with SetHttpClient.  From the outermost in, they are
+// the context set with WithContext (see scopeHttpClient), the
+// middleware, retries, authorization, the cache, coalescing and logging.
+func (pc *Client) buildHttpClient() {
+	client := *pc.httpClient
+	if pc.logger != nil {
+		client.Transport = &transport.Logging{
+			Logger: pc.logger,
+			Levels: pc.logLevels,
+			Base:   client.Transport,
+		}
+	}
+	if pc.coalescing {
+		client.Transport = &transport.Coalesce{
+			Base: client.Transport,
+		}
+	}
+	if pc.cache != nil {
+		client.Transport = pc.cache(client.Transport)
+	}
+	if pc.accessToken != "" {
+		client.Transport = &transport.AccessTokenParam{
+			Token: pc.accessToken,
+			Base:  client.Transport,
+		}
+	}
+	if pc.retryPolicy.MaxAttempts > 1 {
+		// The http.Client's Timeout limits each attempt, rather than
+		// all of them and the waits in between
+		client.Transport = &transport.Retry{
+			Policy:         pc.retryPolicy,
+			Base:           client.Transport,
+			AttemptTimeout: client.Timeout,
+		}
+		client.Timeout = 0
+	}
+	client.Transport = transport.Chain(client.Transport, pc.middleware...)
+	pc.sharedClient = &client
+	pc.scopeHttpClient()
+}
+
+// scopeHttpClient sends the Client's requests with the context set with
+// WithContext, over the RoundTrippers layered by buildHttpClient.
+func (pc *Client) scopeHttpClient() {
+	client := *pc.sharedClient
+	if pc.context != nil {
+		client.Transport = &transport.Scope{
+			Context: pc.context,
+			Base:    client.Transport,
+		}
+	}
+	pc.wreckerClient.HttpClient = &client
+}
@@ -235,7 +235,7 @@ func (suite *ClientTestSuite) TestForbiddenBoardUpdate() {
 	// Try to update Pinterests board!
 	_, err := suite.client.Boards.Update("pinterest/pinterest-100-for-2017",
 		&controllers.BoardUpdateOptionals{
-			Name: "Hello World!",
+			Name: controllers.String("Hello World!"),
 		},
 	)
 	assert.NotEqual(suite.T(), nil, err)
@@ -256,7 +256,7 @@ func (suite *ClientTestSuite) TestNotFoundBoardUpdate() {
 	// Try to update Pinterests board!
 	_, err := suite.client.Boards.Update("BrandonRRomano/E20450921CE",
 		&controllers.BoardUpdateOptionals{
-			Name: "Hello World!",
+			Name: controllers.String("Hello World!"),
 		},
 	)
 	assert.NotEqual(suite.T(), nil, err)
@@ -343,7 +343,7 @@ func (suite *ClientTestSuite) TestSuccessfulBoardCUD() {
 	// Creating the Board
 	board, err := suite.client.Boards.Create("Go Pinterest Test",
 		&controllers.BoardCreateOptionals{
-			Description: "Go Pinterest Test!",
+			Description: controllers.String("Go Pinterest Test!"),
 		},
 	)
 
@@ -357,8 +357,8 @@ func (suite *ClientTestSuite) TestSuccessfulBoardCUD() {
 	// Updating the Board
 	board, err = suite.client.Boards.Update("brandonrromano/go-pinterest-test",
 		&controllers.BoardUpdateOptionals{
-			Name:        "Go Pinterest Test3",
-			Description: "Go Pinterest Test3!",
+			Name:        controllers.String("Go Pinterest Test3"),
+			Description: controllers.String("Go Pinterest Test3!"),
 		},
 	)
 
@@ -509,8 +509,8 @@ func (suite *ClientTestSuite) TestSuccessfulPinCUD() {
 		"brandonrromano/go-pinterest-2",
 		"This is a cat",
 		&controllers.PinCreateOptionals{
-			Link:     "http://www.google.com/",
-			ImageUrl: "http://i.imgur.com/1olmVpO.jpg",
+			Link:     controllers.String("http://www.google.com/"),
+			ImageUrl: controllers.String("http://i.imgur.com/1olmVpO.jpg"),
 		},
 	)
 	assert.Equal(suite.T(), nil, err)
@@ -522,9 +522,9 @@ func (suite *ClientTestSuite) TestSuccessfulPinCUD() {
 	pin, err = suite.client.Pins.Update(
 		pin.Id,
 		&controllers.PinUpdateOptionals{
-			Board: "brandonrromano/go-pinterest",
-			Note:  "This is a new cat",
-			Link:  "http://www.facebook.com/",
+			Board: controllers.String("brandonrromano/go-pinterest"),
+			Note:  controllers.String("This is a new cat"),
+			Link:  controllers.String("http://www.facebook.com/"),
 		},
 	)
 
@@ -549,7 +549,7 @@ func (suite *ClientTestSuite) TestSuccessfulPinFileUpload() {
 		"brandonrromano/go-pinterest-2",
 		"This is a note!",
 		&controllers.PinCreateOptionals{
-			Link:  "https://google.com",
+			Link:  controllers.String("https://google.com"),
 			Image: file,
 		},
 	)
@@ -571,7 +571,7 @@ func (suite *ClientTestSuite) TestUnauthorizedPinCreate() {
 		"brandonrromano/go-pinterest",
 		"Some note, wow",
 		&controllers.PinCreateOptionals{
-			ImageUrl: "http://i.imgur.com/1olmVpO.jpg",
+			ImageUrl: controllers.String("http://i.imgur.com/1olmVpO.jpg"),
 		},
 	)
 	assert.NotEqual(suite.T(), nil, err)
@@ -593,7 +593,7 @@ func (suite *ClientTestSuite) TestForbiddenPinCreate() {
 		"pinterest/pinterest-100-for-2017",
 		"Some note, wow",
 		&controllers.PinCreateOptionals{
-			ImageUrl: "http://i.imgur.com/1olmVpO.jpg",
+			ImageUrl: controllers.String("http://i.imgur.com/1olmVpO.jpg"),
 		},
 	)
 	assert.NotEqual(suite.T(), nil, err)
@@ -615,7 +615,7 @@ func (suite *ClientTestSuite) TestTimeoutPinsCreate() {
 		"pinterest/pinterest-100-for-2017",
 		"Some note, wow",
 		&controllers.PinCreateOptionals{
-			ImageUrl: "http://i.imgur.com/1olmVpO.jpg",
+			ImageUrl: controllers.String("http://i.imgur.com/1olmVpO.jpg"),
 		},
 	)
 	assert.NotEqual(suite.T(), nil, err)
@@ -631,7 +631,7 @@ func (suite *ClientTestSuite) TestUnauthorizedPinUpdate() {
 	_, err := suite.unauthorizedClient.Pins.Update(
 		"192880796521721688",
 		&controllers.PinUpdateOptionals{
-			Note: "Hello Update!",
+			Note: controllers.String("Hello Update!"),
 		},
 	)
 	assert.NotEqual(suite.T(), nil, err)
@@ -652,7 +652,7 @@ func (suite *ClientTestSuite) TestForbiddenPinUpdate() {
 	_, err := suite.client.Pins.Update(
 		"424605071105031783",
 		&controllers.PinUpdateOptionals{
-			Note: "Hello Update!",
+			Note: controllers.String("Hello Update!"),
 		},
 	)
 	assert.NotEqual(suite.T(), nil, err)
@@ -673,7 +673,7 @@ func (suite *ClientTestSuite) TestTimeoutPinUpdate() {
 	_, err := suite.timeoutClient.Pins.Update(
 		"192880796521721688",
 		&controllers.PinUpdateOptionals{
-			Note: "Hello Update!",
+			Note: controllers.String("Hello Update!"),
 		},
 	)
 	assert.NotEqual(suite.T(), nil, err)
@@ -814,7 +814,7 @@ func (suite *ClientTestSuite) TestSuccessfulMeBoardsSuggestedFetch() {
 	boards, err := suite.client.Me.Boards.Suggested.Fetch(
 		&controllers.MeBoardsSuggestedFetchOptionals{
 			Count: 1,
-			Pin:   "192880796521721689",
+			Pin:   controllers.String("192880796521721689"),
 		},
 	)
 	assert.Equal(suite.T(), nil, err)
@@ -864,7 +864,7 @@ func (suite *ClientTestSuite) TestSuccessfulMeFollowersFetch() {
 	// Load second page
 	users, page, err = suite.client.Me.Followers.Fetch(
 		&controllers.MeFollowersFetchOptionals{
-			Cursor: page.Cursor,
+			Cursor: controllers.String(page.Cursor),
 		},
 	)
 	assert.Equal(suite.T(), nil, err)
@@ -914,7 +914,7 @@ func (suite *ClientTestSuite) TestSuccessfulMeFollowingBoardsFetch() {
 	// Load second page
 	boards, page, err = suite.client.Me.Following.Boards.Fetch(
 		&controllers.MeFollowingBoardsFetchOptionals{
-			Cursor: page.Cursor,
+			Cursor: controllers.String(page.Cursor),
 		},
 	)
 	assert.Equal(suite.T(), nil, err)
@@ -1032,7 +1032,7 @@ func (suite *ClientTestSuite) TestSuccessfulMeFollowingInterestsFetch() {
 	// Load second page
 	interests, page, err = suite.client.Me.Following.Interests.Fetch(
 		&controllers.MeFollowingInterestsFetchOptionals{
-			Cursor: page.Cursor,
+			Cursor: controllers.String(page.Cursor),
 		},
 	)
 	assert.Equal(suite.T(), nil, err)
@@ -1084,7 +1084,7 @@ func (suite *ClientTestSuite) TestSuccessfulMeFollowingUsersFetch() {
 	// Load second page
 	users, _, err = suite.client.Me.Following.Users.Fetch(
 		&controllers.FollowingUsersControllerFetchOptionals{
-			Cursor: page.Cursor,
+			Cursor: controllers.String(page.Cursor),
 			Limit:  3,
 		},
 	)
@@ -1236,7 +1236,7 @@ func (suite *ClientTestSuite) TestSuccessfulMePinsFetch() {
 	// Load second page
 	pins, page, err = suite.client.Me.Pins.Fetch(
 		&controllers.MePinsFetchOptionals{
-			Cursor: page.Cursor,
+			Cursor: controllers.String(page.Cursor),
 		},
 	)
 	assert.Equal(suite.T(), nil, err)
@@ -1292,7 +1292,7 @@ func (suite *ClientTestSuite) TestSuccessfulMeSearchBoardsFetch() {
 		"Go Pinterest",
 		&controllers.MeSearchBoardsFetchOptionals{
 			Limit:  1,
-			Cursor: page.Cursor,
+			Cursor: controllers.String(page.Cursor),
 		},
 	)
 	assert.Equal(suite.T(), nil, err)
@@ -1350,7 +1350,7 @@ func (suite *ClientTestSuite) TestSuccessfulMeSearchPinsFetch() {
 		"Go Gopher",
 		&controllers.MeSearchPinsFetchOptionals{
 			Limit:  1,
-			Cursor: page.Cursor,
+			Cursor: controllers.String(page.Cursor),
 		},
 	)
 	assert.Equal(suite.T(), nil, err)
@@ -1413,3 +1413,17 @@ func (suite *ClientTestSuite) TestUnauthorizedOAuthTokenCreate() {
 		assert.Equal(suite.T(), true, false)
 	}
 }
+
+// ===============================
+// ========== BoardSpec ==========
+// ===============================
+
+// TestInvalidBoardSpec tests that malformed board specs are rejected
+// before any request is sent.
+func (suite *ClientTestSuite) TestInvalidBoardSpec() {
+	// Should never make it to the network
+	_, err := suite.unauthorizedClient.Boards.Fetch("BrandonRRomano/go-pinterest?x=1/extra")
+	_, isPinterestError := err.(*models.PinterestError)
+	assert.NotEqual(suite.T(), nil, err)
+	assert.Equal(suite.T(), false, isPinterestError)
+}
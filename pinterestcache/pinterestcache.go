@@ -0,0 +1,368 @@
+// Package pinterestcache caches the responses of GET requests made by
+// the v1 and v5 Pinterest clients, so that workers fetching the same boards
+// and users don't each spend their rate limit on them.
+//
+// Install a Cache on a client with SetCache:
+//
+//	store := pinterestcache.NewLRUStore(10000)
+//	client := pinterest.NewClient().
+//		RegisterAccessToken("USERS_ACCESS_TOKEN").
+//		SetCache(pinterestcache.New(store, nil).Wrap)
+//
+// Responses are cached per access token, so clients authorized as
+// different users can share a Store without seeing each other's responses.
+// Requests that aren't authorized (such as those of a Cache added with Use,
+// which sees requests before they are authorized) aren't cached.
+//
+// Responses are fresh for the TTL of their resource.  Once stale, those
+// with an ETag are revalidated with If-None-Match, and reused if they
+// haven't changed.
+//
+// Rate limit headers (such as X-Ratelimit-Remaining) aren't cached, so
+// that responses replayed from the cache don't report a stale rate limit
+// to the client's logger and metrics.  Revalidated responses carry those
+// of the request that revalidated them.
+//
+// When the client updates or deletes an object (any successful request
+// that isn't a GET), the responses it cached for the object are dropped,
+// along with those of its sub-paths (such as a board's pins and sections)
+// and of the collection above it (such as /v5/boards).  Responses of other
+// paths that include the object (such as the pins of the board a pin was
+// saved to), and those cached for other access tokens, are kept until they
+// go stale.
+package pinterestcache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Config is a struct that represents how a Cache caches responses
+type Config struct {
+	// DefaultTTL is how long responses stay fresh, unless their resource
+	// has its own TTL.  Defaults to 1 minute.
+	DefaultTTL time.Duration
+
+	// TTLs overrides DefaultTTL by resource, which is the first segment
+	// of the path after the API version (such as "boards", "users" or
+	// "user_account").  Resources with a TTL of 0 aren't cached.
+	TTLs map[string]time.Duration
+
+	// RetainStale is how long responses with an ETag are kept after they
+	// go stale, so they can be revalidated.  Defaults to 24 hours.
+	RetainStale time.Duration
+
+	// OnError, if set, is called with the errors of the Store.
+	// A request whose entry can't be read or written is sent as usual.
+	OnError func(err error)
+}
+
+// Cache is a middleware that caches the responses of GET requests
+// in a Store.
+type Cache struct {
+	store       Store
+	defaultTTL  time.Duration
+	ttls        map[string]time.Duration
+	retainStale time.Duration
+	onError     func(err error)
+}
+
+// New instantiates a new Cache, which keeps its entries in store.
+func New(store Store, config *Config) *Cache {
+	// Default config
+	if config == nil {
+		config = &Config{}
+	}
+	defaultTTL := config.DefaultTTL
+	if defaultTTL <= 0 {
+		defaultTTL = time.Minute
+	}
+	retainStale := config.RetainStale
+	if retainStale <= 0 {
+		retainStale = 24 * time.Hour
+	}
+
+	return &Cache{
+		store:       store,
+		defaultTTL:  defaultTTL,
+		ttls:        config.TTLs,
+		retainStale: retainStale,
+		onError:     config.OnError,
+	}
+}
+
+// Wrap returns a RoundTripper that caches the responses of the requests
+// sent through next.  Pass it to a client's SetCache.
+func (c *Cache) Wrap(next http.RoundTripper) http.RoundTripper {
+	return &roundTripper{cache: c, next: next}
+}
+
+// entry is what a Cache stores for an object: the responses to each of
+// the sub-paths and query strings it was requested with.
+type entry struct {
+	Variants map[string]*cachedResponse `json:"variants"`
+}
+
+// cachedResponse is a response that was cached.
+type cachedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	ETag       string      `json:"etag"`
+	FreshUntil time.Time   `json:"fresh_until"`
+}
+
+// keepUntil returns when the response can be forgotten.
+func (cr *cachedResponse) keepUntil(retainStale time.Duration) time.Time {
+	if cr.ETag != "" {
+		return cr.FreshUntil.Add(retainStale)
+	}
+	return cr.FreshUntil
+}
+
+// response returns the cached response as a reply to req.
+func (cr *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", cr.StatusCode, http.StatusText(cr.StatusCode)),
+		StatusCode:    cr.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        cr.Header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(cr.Body)),
+		ContentLength: int64(len(cr.Body)),
+		Request:       req,
+	}
+}
+
+// roundTripper is the http.RoundTripper returned by Wrap.
+type roundTripper struct {
+	cache *Cache
+	next  http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	c := rt.cache
+	owner := fingerprint(req)
+	if owner == "" {
+		return rt.next.RoundTrip(req)
+	}
+	object, subpath := splitPath(req.URL.Path)
+
+	// Updates and deletes invalidate the object, and the collection above it
+	if req.Method != "GET" {
+		var keys []string
+		for _, path := range invalidated(object) {
+			keys = append(keys, c.key(owner, req.URL.Host, path))
+		}
+		resp, err := rt.next.RoundTrip(req)
+		if err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			for _, key := range keys {
+				c.report(c.store.Delete(key))
+			}
+		}
+		return resp, err
+	}
+	ttl := c.ttl(req.URL.Path)
+	if ttl <= 0 {
+		return rt.next.RoundTrip(req)
+	}
+
+	// Reply with a fresh response, or revalidate a stale one
+	key := c.key(owner, req.URL.Host, object)
+	variant := subpath + "?" + query(req)
+	stored := c.load(key)
+	cached := stored.Variants[variant]
+	if cached != nil && time.Now().Before(cached.FreshUntil) {
+		return cached.response(req), nil
+	}
+	sent := req
+	if cached != nil && cached.ETag != "" {
+		sent = req.Clone(req.Context())
+		sent.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	// Send request
+	resp, err := rt.next.RoundTrip(sent)
+	if err != nil {
+		return resp, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+		cached.FreshUntil = time.Now().Add(ttl)
+		c.save(key, variant, cached)
+		revalidated := cached.response(req)
+		for name, values := range resp.Header {
+			if isRateLimitHeader(name) {
+				revalidated.Header[name] = values
+			}
+		}
+		return revalidated, nil
+	case resp.StatusCode == http.StatusOK && !strings.Contains(resp.Header.Get("Cache-Control"), "no-store"):
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		c.save(key, variant, &cachedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     withoutRateLimit(resp.Header),
+			Body:       body,
+			ETag:       resp.Header.Get("ETag"),
+			FreshUntil: time.Now().Add(ttl),
+		})
+	}
+	return resp, nil
+}
+
+// isRateLimitHeader returns whether the header named name
+// reports the rate limit of the request.
+func isRateLimitHeader(name string) bool {
+	return strings.HasPrefix(http.CanonicalHeaderKey(name), "X-Ratelimit-")
+}
+
+// withoutRateLimit returns a copy of header without its rate limit headers.
+func withoutRateLimit(header http.Header) http.Header {
+	header = header.Clone()
+	for name := range header {
+		if isRateLimitHeader(name) {
+			delete(header, name)
+		}
+	}
+	return header
+}
+
+// key returns the key of the entry of the object at path on host,
+// as cached for owner.
+func (c *Cache) key(owner string, host string, path string) string {
+	return owner + " " + host + path
+}
+
+// fingerprint returns a hash of the access token that req is authorized
+// with, whether in its Authorization header (v5) or its query (v1), or ""
+// if it isn't authorized.
+func fingerprint(req *http.Request) string {
+	authorization := req.Header.Get("Authorization")
+	token := req.URL.Query().Get("access_token")
+	if authorization == "" && token == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(authorization + "\n" + token))
+	return hex.EncodeToString(sum[:16])
+}
+
+// query returns the encoded query of req, without its access token, which
+// mustn't be stored.
+func query(req *http.Request) string {
+	values := req.URL.Query()
+	values.Del("access_token")
+	return values.Encode()
+}
+
+// splitPath splits path into the path of the object it belongs to, which
+// is its first three segments (such as /v5/boards/549755885175), and the
+// sub-path of the object that it requests (such as /pins).
+func splitPath(path string) (object string, subpath string) {
+	segments := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 4)
+	if len(segments) > 3 {
+		segments = segments[:3]
+	}
+	object = strings.TrimSuffix("/"+strings.Join(segments, "/"), "/")
+	return object, path[len(object):]
+}
+
+// invalidated returns the paths of the objects whose entries are dropped
+// when object is updated: the object itself, and the collection
+// above it (such as /v5/boards).
+func invalidated(object string) []string {
+	paths := []string{object}
+	if segments := strings.Split(strings.TrimPrefix(object, "/"), "/"); len(segments) > 2 {
+		paths = append(paths, "/"+strings.Join(segments[:2], "/"))
+	}
+	return paths
+}
+
+// ttl returns how long responses for the path stay fresh.
+func (c *Cache) ttl(path string) time.Duration {
+	// Skip the API version, such as v5
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 {
+		return c.defaultTTL
+	}
+	if ttl, ok := c.ttls[segments[1]]; ok {
+		return ttl
+	}
+	return c.defaultTTL
+}
+
+// load returns the entry stored under key, or an empty one.
+func (c *Cache) load(key string) *entry {
+	stored := &entry{Variants: map[string]*cachedResponse{}}
+	data, ok, err := c.store.Get(key)
+	if err != nil || !ok {
+		c.report(err)
+		return stored
+	}
+	if err := json.Unmarshal(data, stored); err != nil {
+		c.report(err)
+		return &entry{Variants: map[string]*cachedResponse{}}
+	}
+	if stored.Variants == nil {
+		stored.Variants = map[string]*cachedResponse{}
+	}
+	return stored
+}
+
+// save stores a response under key, along with the other
+// variants of the entry that haven't expired.
+//
+// The entry is loaded, modified and stored again without a lock, so two
+// responses saved for the same object at once may overwrite each other,
+// and a response received while the object is updated may be saved after
+// it is invalidated.  This is accepted, rather than locking the Store
+// (which may be shared by other processes): the variant that is lost is
+// sent again when it is next requested, and the outdated one is only
+// served until it goes stale.
+func (c *Cache) save(key string, variant string, cached *cachedResponse) {
+	stored := c.load(key)
+	stored.Variants[variant] = cached
+
+	now := time.Now()
+	var keepUntil time.Time
+	for name, response := range stored.Variants {
+		until := response.keepUntil(c.retainStale)
+		if until.Before(now) {
+			delete(stored.Variants, name)
+			continue
+		}
+		if until.After(keepUntil) {
+			keepUntil = until
+		}
+	}
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		c.report(err)
+		return
+	}
+	c.report(c.store.Set(key, data, keepUntil.Sub(now)))
+}
+
+// report passes err to OnError, if both are set.
+func (c *Cache) report(err error) {
+	if err != nil && c.onError != nil {
+		c.onError(err)
+	}
+}
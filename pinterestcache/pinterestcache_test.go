@@ -0,0 +1,191 @@
+package pinterestcache_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/carrot/go-pinterest/pinterestcache"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAPI replies to every request with a 200 and an ETag, or a 304
+// if the request has the ETag, counting them by method and path.  Each
+// reply has one less request remaining in its rate limit.
+type fakeAPI struct {
+	sent       map[string]int
+	revalidate map[string]int
+	remaining  int
+}
+
+func (fa *fakeAPI) RoundTrip(req *http.Request) (*http.Response, error) {
+	fa.sent[req.Method+" "+req.URL.Path]++
+	fa.remaining--
+	rateLimit := strconv.Itoa(fa.remaining)
+	if req.Header.Get("If-None-Match") == `"abc"` {
+		fa.revalidate[req.URL.Path]++
+		return &http.Response{
+			StatusCode: 304,
+			Header:     http.Header{"X-Ratelimit-Remaining": {rateLimit}},
+			Body:       http.NoBody,
+			Request:    req,
+		}, nil
+	}
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"Etag": {`"abc"`}, "X-Ratelimit-Remaining": {rateLimit}},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"id": "1"}`)),
+		Request:    req,
+	}, nil
+}
+
+// send sends a request for the path, authorized with token
+// as the v5 client would.
+func send(t *testing.T, rt http.RoundTripper, method string, path string, token string) {
+	req, _ := http.NewRequest(method, "https://api.pinterest.com"+path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := rt.RoundTrip(req)
+	assert.Nil(t, err)
+	resp.Body.Close()
+}
+
+// newCache returns a Cache over the fake API, and the API.
+func newCache(store pinterestcache.Store) (http.RoundTripper, *fakeAPI) {
+	return newCacheWithTTL(store, time.Hour)
+}
+
+// newCacheWithTTL returns a Cache whose responses are fresh
+// for ttl over the fake API, and the API.
+func newCacheWithTTL(store pinterestcache.Store, ttl time.Duration) (http.RoundTripper, *fakeAPI) {
+	api := &fakeAPI{sent: map[string]int{}, revalidate: map[string]int{}, remaining: 1000}
+	cache := pinterestcache.New(store, &pinterestcache.Config{DefaultTTL: ttl})
+	return cache.Wrap(api), api
+}
+
+// TestCacheAccessTokens tests that responses are only shared by
+// requests authorized with the same access token.
+func TestCacheAccessTokens(t *testing.T) {
+	rt, api := newCache(pinterestcache.NewLRUStore(100))
+
+	send(t, rt, "GET", "/v5/boards/1", "first-token")
+	send(t, rt, "GET", "/v5/boards/1", "first-token")
+	assert.Equal(t, 1, api.sent["GET /v5/boards/1"])
+
+	send(t, rt, "GET", "/v5/boards/1", "second-token")
+	assert.Equal(t, 2, api.sent["GET /v5/boards/1"])
+
+	// Requests that aren't authorized aren't cached
+	send(t, rt, "GET", "/v5/boards/1", "")
+	send(t, rt, "GET", "/v5/boards/1", "")
+	assert.Equal(t, 4, api.sent["GET /v5/boards/1"])
+}
+
+// TestCacheRevalidation tests that stale responses with an ETag are
+// revalidated, and reused if they haven't changed.
+func TestCacheRevalidation(t *testing.T) {
+	rt, api := newCacheWithTTL(pinterestcache.NewLRUStore(100), time.Nanosecond)
+
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequest("GET", "https://api.pinterest.com/v5/boards/1", nil)
+		req.Header.Set("Authorization", "Bearer token")
+		resp, err := rt.RoundTrip(req)
+		assert.Nil(t, err)
+		assert.Equal(t, 200, resp.StatusCode)
+		body, _ := ioutil.ReadAll(resp.Body)
+		assert.Equal(t, `{"id": "1"}`, string(body))
+	}
+	assert.Equal(t, 3, api.sent["GET /v5/boards/1"])
+	assert.Equal(t, 2, api.revalidate["/v5/boards/1"])
+}
+
+// TestCacheRateLimit tests that cached responses don't replay the rate
+// limit of the response they were cached from, and that revalidated ones
+// report the rate limit of the request that revalidated them.
+func TestCacheRateLimit(t *testing.T) {
+	fetch := func(rt http.RoundTripper) string {
+		req, _ := http.NewRequest("GET", "https://api.pinterest.com/v5/boards/1", nil)
+		req.Header.Set("Authorization", "Bearer token")
+		resp, err := rt.RoundTrip(req)
+		assert.Nil(t, err)
+		resp.Body.Close()
+		return resp.Header.Get("X-Ratelimit-Remaining")
+	}
+
+	rt, _ := newCache(pinterestcache.NewLRUStore(100))
+	assert.Equal(t, "999", fetch(rt))
+	assert.Equal(t, "", fetch(rt))
+
+	rt, _ = newCacheWithTTL(pinterestcache.NewLRUStore(100), time.Nanosecond)
+	assert.Equal(t, "999", fetch(rt))
+	assert.Equal(t, "998", fetch(rt))
+	assert.Equal(t, "997", fetch(rt))
+}
+
+// TestCacheAccessTokenParam tests that v1 requests, which are authorized
+// by their query, are cached per access token, and that the token
+// isn't stored.
+func TestCacheAccessTokenParam(t *testing.T) {
+	store := &recordingStore{Store: pinterestcache.NewLRUStore(100)}
+	rt, api := newCache(store)
+
+	for _, token := range []string{"first-token", "first-token", "second-token"} {
+		req, _ := http.NewRequest("GET", "https://api.pinterest.com/v1/pins/1/?fields=id&access_token="+token, nil)
+		resp, err := rt.RoundTrip(req)
+		assert.Nil(t, err)
+		resp.Body.Close()
+	}
+	assert.Equal(t, 2, api.sent["GET /v1/pins/1/"])
+	for key, value := range store.set {
+		assert.NotContains(t, key, "token")
+		assert.NotContains(t, string(value), "token")
+	}
+}
+
+// TestCacheInvalidation tests that a write drops the responses cached
+// for its object, the object's sub-paths and the collection above it,
+// but not those of other objects or other access tokens.
+func TestCacheInvalidation(t *testing.T) {
+	rt, api := newCache(pinterestcache.NewLRUStore(100))
+	paths := []string{"/v5/boards", "/v5/boards/1", "/v5/boards/1/pins", "/v5/boards/2"}
+	for _, path := range paths {
+		send(t, rt, "GET", path, "first-token")
+		send(t, rt, "GET", path, "second-token")
+	}
+
+	send(t, rt, "PATCH", "/v5/boards/1", "first-token")
+	for _, path := range paths {
+		send(t, rt, "GET", path, "first-token")
+		send(t, rt, "GET", path, "second-token")
+	}
+	assert.Equal(t, map[string]int{
+		"GET /v5/boards":        3,
+		"GET /v5/boards/1":      3,
+		"GET /v5/boards/1/pins": 3,
+		"GET /v5/boards/2":      2,
+		"PATCH /v5/boards/1":    1,
+	}, api.sent)
+
+	// Writes to a sub-path invalidate the object it belongs to
+	send(t, rt, "POST", "/v5/boards/2/sections", "first-token")
+	send(t, rt, "GET", "/v5/boards/2", "first-token")
+	assert.Equal(t, 3, api.sent["GET /v5/boards/2"])
+}
+
+// recordingStore is a Store that records what is set in it.
+type recordingStore struct {
+	pinterestcache.Store
+	set map[string][]byte
+}
+
+func (rs *recordingStore) Set(key string, value []byte, ttl time.Duration) error {
+	if rs.set == nil {
+		rs.set = map[string][]byte{}
+	}
+	rs.set[key] = value
+	return rs.Store.Set(key, value, ttl)
+}
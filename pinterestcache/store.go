@@ -0,0 +1,224 @@
+package pinterestcache
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Store is where a Cache keeps its entries.  Implementations must be safe
+// to use from multiple goroutines.
+type Store interface {
+	// Get returns the value stored under key, or false if
+	// there is none (or it has expired).
+	Get(key string) ([]byte, bool, error)
+
+	// Set stores value under key, for ttl.
+	Set(key string, value []byte, ttl time.Duration) error
+
+	// Delete removes the value stored under key, if there is one.
+	Delete(key string) error
+}
+
+// LRUStore is an in-memory Store that holds up to a fixed number of
+// entries, evicting the least recently used when it is full.
+type LRUStore struct {
+	capacity int
+	mutex    sync.Mutex
+	order    *list.List
+	items    map[string]*list.Element
+}
+
+// lruItem is an entry of an LRUStore.
+type lruItem struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewLRUStore instantiates a new LRUStore that holds up to capacity entries.
+func NewLRUStore(capacity int) *LRUStore {
+	return &LRUStore{
+		capacity: capacity,
+		order:    list.New(),
+		items:    map[string]*list.Element{},
+	}
+}
+
+// Get implements Store.
+func (s *LRUStore) Get(key string) ([]byte, bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	element, ok := s.items[key]
+	if !ok {
+		return nil, false, nil
+	}
+	item := element.Value.(*lruItem)
+	if time.Now().After(item.expiresAt) {
+		s.order.Remove(element)
+		delete(s.items, key)
+		return nil, false, nil
+	}
+	s.order.MoveToFront(element)
+	return item.value, true, nil
+}
+
+// Set implements Store.
+func (s *LRUStore) Set(key string, value []byte, ttl time.Duration) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	item := &lruItem{key: key, value: value, expiresAt: time.Now().Add(ttl)}
+	if element, ok := s.items[key]; ok {
+		element.Value = item
+		s.order.MoveToFront(element)
+		return nil
+	}
+	s.items[key] = s.order.PushFront(item)
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.items, oldest.Value.(*lruItem).key)
+	}
+	return nil
+}
+
+// Delete implements Store.
+func (s *LRUStore) Delete(key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if element, ok := s.items[key]; ok {
+		s.order.Remove(element)
+		delete(s.items, key)
+	}
+	return nil
+}
+
+// DiskStore is a Store that keeps each entry in a file of a directory,
+// so that entries outlive the process.  Expired files are removed when
+// they are read.
+type DiskStore struct {
+	dir string
+}
+
+// diskRecord is the content of a DiskStore file.
+type diskRecord struct {
+	ExpiresAt time.Time `json:"expires_at"`
+	Value     []byte    `json:"value"`
+}
+
+// NewDiskStore instantiates a new DiskStore in dir,
+// creating it if it doesn't exist.
+func NewDiskStore(dir string) (*DiskStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &DiskStore{dir: dir}, nil
+}
+
+// Get implements Store.
+func (s *DiskStore) Get(key string) ([]byte, bool, error) {
+	data, err := ioutil.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	var record diskRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, false, err
+	}
+	if time.Now().After(record.ExpiresAt) {
+		return nil, false, s.Delete(key)
+	}
+	return record.Value, true, nil
+}
+
+// Set implements Store.
+func (s *DiskStore) Set(key string, value []byte, ttl time.Duration) error {
+	data, err := json.Marshal(diskRecord{ExpiresAt: time.Now().Add(ttl), Value: value})
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so readers never see half an entry
+	file, err := ioutil.TempFile(s.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+	return os.Rename(file.Name(), s.path(key))
+}
+
+// Delete implements Store.
+func (s *DiskStore) Delete(key string) error {
+	err := os.Remove(s.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// path returns the path of the file that holds key.
+func (s *DiskStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:]))
+}
+
+// RedisClient is the subset of a Redis client that RedisStore needs.
+// Adapt your Redis client of choice to it; for example, with go-redis:
+//
+//	func (a adapter) Get(key string) ([]byte, bool, error) {
+//		value, err := a.client.Get(ctx, key).Bytes()
+//		if err == redis.Nil {
+//			return nil, false, nil
+//		}
+//		return value, err == nil, err
+//	}
+type RedisClient interface {
+	Get(key string) ([]byte, bool, error)
+	Set(key string, value []byte, ttl time.Duration) error
+	Del(key string) error
+}
+
+// RedisStore is a Store backed by Redis, so that entries
+// are shared by every process using the same server.
+type RedisStore struct {
+	client RedisClient
+	prefix string
+}
+
+// NewRedisStore instantiates a new RedisStore, which prefixes
+// its keys with prefix (such as "pinterest:").
+func NewRedisStore(client RedisClient, prefix string) *RedisStore {
+	return &RedisStore{client: client, prefix: prefix}
+}
+
+// Get implements Store.
+func (s *RedisStore) Get(key string) ([]byte, bool, error) {
+	return s.client.Get(s.prefix + key)
+}
+
+// Set implements Store.
+func (s *RedisStore) Set(key string, value []byte, ttl time.Duration) error {
+	return s.client.Set(s.prefix+key, value, ttl)
+}
+
+// Delete implements Store.
+func (s *RedisStore) Delete(key string) error {
+	return s.client.Del(s.prefix + key)
+}
@@ -0,0 +1,119 @@
+package pinterestcache_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/carrot/go-pinterest/pinterestcache"
+	"github.com/stretchr/testify/assert"
+)
+
+// testStore tests that store returns what was set in it until it
+// expires or is deleted.
+func testStore(t *testing.T, store pinterestcache.Store) {
+	value, ok, err := store.Get("missing")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, value)
+
+	// Values are returned until they are deleted
+	assert.Nil(t, store.Set("board", []byte(`{"id": "1"}`), time.Hour))
+	value, ok, err = store.Get("board")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, `{"id": "1"}`, string(value))
+	assert.Nil(t, store.Set("board", []byte(`{"id": "2"}`), time.Hour))
+	value, _, _ = store.Get("board")
+	assert.Equal(t, `{"id": "2"}`, string(value))
+	assert.Nil(t, store.Delete("board"))
+	_, ok, err = store.Get("board")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, store.Delete("board"))
+
+	// Values aren't returned once they expire
+	assert.Nil(t, store.Set("pin", []byte(`{"id": "3"}`), time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	_, ok, err = store.Get("pin")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+// TestLRUStore tests the LRUStore, and that it evicts the least
+// recently used entry when it is full.
+func TestLRUStore(t *testing.T) {
+	testStore(t, pinterestcache.NewLRUStore(10))
+
+	store := pinterestcache.NewLRUStore(2)
+	store.Set("first", []byte("1"), time.Hour)
+	store.Set("second", []byte("2"), time.Hour)
+	store.Get("first")
+	store.Set("third", []byte("3"), time.Hour)
+	_, ok, _ := store.Get("first")
+	assert.True(t, ok)
+	_, ok, _ = store.Get("second")
+	assert.False(t, ok)
+	_, ok, _ = store.Get("third")
+	assert.True(t, ok)
+}
+
+// TestDiskStore tests the DiskStore, and that its
+// entries outlive the store.
+func TestDiskStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := pinterestcache.NewDiskStore(dir)
+	assert.Nil(t, err)
+	testStore(t, store)
+
+	store.Set("boards/1", []byte(`{"id": "1"}`), time.Hour)
+	reopened, err := pinterestcache.NewDiskStore(dir)
+	assert.Nil(t, err)
+	value, ok, err := reopened.Get("boards/1")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, `{"id": "1"}`, string(value))
+}
+
+// fakeRedis is a RedisClient that keeps its values in a map.
+type fakeRedis struct {
+	mutex  sync.Mutex
+	values map[string][]byte
+	expiry map[string]time.Time
+}
+
+func (fr *fakeRedis) Get(key string) ([]byte, bool, error) {
+	fr.mutex.Lock()
+	defer fr.mutex.Unlock()
+	value, ok := fr.values[key]
+	if !ok || time.Now().After(fr.expiry[key]) {
+		return nil, false, nil
+	}
+	return value, true, nil
+}
+
+func (fr *fakeRedis) Set(key string, value []byte, ttl time.Duration) error {
+	fr.mutex.Lock()
+	defer fr.mutex.Unlock()
+	fr.values[key] = value
+	fr.expiry[key] = time.Now().Add(ttl)
+	return nil
+}
+
+func (fr *fakeRedis) Del(key string) error {
+	fr.mutex.Lock()
+	defer fr.mutex.Unlock()
+	delete(fr.values, key)
+	delete(fr.expiry, key)
+	return nil
+}
+
+// TestRedisStore tests the RedisStore, and that it prefixes its keys.
+func TestRedisStore(t *testing.T) {
+	redis := &fakeRedis{values: map[string][]byte{}, expiry: map[string]time.Time{}}
+	testStore(t, pinterestcache.NewRedisStore(redis, "pinterest:"))
+
+	pinterestcache.NewRedisStore(redis, "pinterest:").Set("boards/1", []byte("1"), time.Hour)
+	_, ok := redis.values["pinterest:boards/1"]
+	assert.True(t, ok)
+}
@@ -0,0 +1,198 @@
+// Package pinterestotel instruments the v1 and v5 Pinterest clients with
+// OpenTelemetry.  Each request is traced with a client span, which is named
+// after the API call it was sent for (such as "pinterest.Pins.Create"), and
+// counted by the request, error, latency and rate limit metrics.  The
+// identifiers in the path are left out of the span (see
+// transport.RedactPath), and query strings are never recorded.
+//
+// Install it with Use:
+//
+//	instrumentation, err := pinterestotel.New(nil)
+//	client := v5.NewClient().
+//		RegisterAccessToken("USERS_ACCESS_TOKEN").
+//		Use(instrumentation.Wrap)
+//
+// To make a request's span a child of the caller's, send it through
+// a client returned by the v1 or v5 Client's WithContext.
+package pinterestotel
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/carrot/go-pinterest/transport"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name the tracer and meter are created with.
+const instrumentationName = "github.com/carrot/go-pinterest/pinterestotel"
+
+// Config is a struct that represents how requests are instrumented.
+// Providers that aren't set default to the global provider.
+type Config struct {
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+	Propagator     propagation.TextMapPropagator
+
+	// Path, if set, returns the path that a request's span is attributed
+	// with, such as to record paths in full.  Defaults to
+	// transport.RedactPath.
+	Path func(req *http.Request) string
+}
+
+// Instrumentation traces and measures the requests of the
+// clients it is installed on.
+type Instrumentation struct {
+	tracer             trace.Tracer
+	propagator         propagation.TextMapPropagator
+	path               func(req *http.Request) string
+	requests           metric.Int64Counter
+	errors             metric.Int64Counter
+	duration           metric.Float64Histogram
+	rateLimitRemaining metric.Int64Gauge
+}
+
+// New instantiates a new Instrumentation, creating its metrics.
+func New(config *Config) (*Instrumentation, error) {
+	// Default config
+	if config == nil {
+		config = &Config{}
+	}
+	tracerProvider := config.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	meterProvider := config.MeterProvider
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+	propagator := config.Propagator
+	if propagator == nil {
+		propagator = otel.GetTextMapPropagator()
+	}
+	path := config.Path
+	if path == nil {
+		path = transport.RedactPath
+	}
+
+	// Create metrics
+	meter := meterProvider.Meter(instrumentationName)
+	requests, err := meter.Int64Counter("pinterest.client.requests",
+		metric.WithDescription("Requests sent to the Pinterest API"),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+	errors, err := meter.Int64Counter("pinterest.client.errors",
+		metric.WithDescription("Requests to the Pinterest API that failed or returned an error status"),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+	duration, err := meter.Float64Histogram("pinterest.client.request.duration",
+		metric.WithDescription("Time taken by requests to the Pinterest API, including retries"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return nil, err
+	}
+	rateLimitRemaining, err := meter.Int64Gauge("pinterest.client.ratelimit.remaining",
+		metric.WithDescription("Requests remaining in the current rate limit window, from X-Ratelimit-Remaining"),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Instrumentation{
+		tracer:             tracerProvider.Tracer(instrumentationName),
+		propagator:         propagator,
+		path:               path,
+		requests:           requests,
+		errors:             errors,
+		duration:           duration,
+		rateLimitRemaining: rateLimitRemaining,
+	}, nil
+}
+
+// Wrap returns a RoundTripper that instruments the requests sent
+// through base.  Pass it to a client's Use.
+func (i *Instrumentation) Wrap(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &roundTripper{instrumentation: i, base: base}
+}
+
+// roundTripper is the http.RoundTripper returned by Wrap.
+type roundTripper struct {
+	instrumentation *Instrumentation
+	base            http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	i := rt.instrumentation
+
+	// Start a span, named after the API call if the request is for one
+	operation := transport.Operation(req.Context())
+	name := "pinterest " + req.Method
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+	}
+	if operation != "" {
+		name = "pinterest." + operation
+		attrs = append(attrs, attribute.String("pinterest.operation", operation))
+	}
+	ctx, span := i.tracer.Start(req.Context(), name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+		trace.WithAttributes(
+			attribute.String("server.address", req.URL.Hostname()),
+			attribute.String("url.path", i.path(req)),
+		))
+	defer span.End()
+
+	// Propagate the span to Pinterest
+	req = req.Clone(ctx)
+	i.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	// Send request
+	start := time.Now()
+	resp, err := rt.base.RoundTrip(req)
+	elapsed := time.Since(start)
+
+	// Record outcome
+	outcome := append([]attribute.KeyValue(nil), attrs...)
+	failed := true
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		outcome = append(outcome, attribute.String("error.type", "transport"))
+	} else {
+		status := attribute.Int("http.response.status_code", resp.StatusCode)
+		span.SetAttributes(status)
+		outcome = append(outcome, status)
+		if resp.StatusCode >= 400 {
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+			outcome = append(outcome, attribute.String("error.type", strconv.Itoa(resp.StatusCode)))
+		} else {
+			failed = false
+		}
+		if remaining, err := strconv.ParseInt(resp.Header.Get("X-Ratelimit-Remaining"), 10, 64); err == nil {
+			i.rateLimitRemaining.Record(ctx, remaining, metric.WithAttributes(attrs...))
+		}
+	}
+
+	measured := metric.WithAttributes(outcome...)
+	i.requests.Add(ctx, 1, measured)
+	if failed {
+		i.errors.Add(ctx, 1, measured)
+	}
+	i.duration.Record(ctx, elapsed.Seconds(), measured)
+	return resp, err
+}
@@ -0,0 +1,151 @@
+package pinterestotel_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/carrot/go-pinterest/pinterestotel"
+	"github.com/carrot/go-pinterest/transport"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrument returns a RoundTripper that instruments the requests sent
+// through api, along with the recorder of its spans and the reader of
+// its metrics.
+func instrument(t *testing.T, api http.RoundTripper, config *pinterestotel.Config) (http.RoundTripper, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	if config == nil {
+		config = &pinterestotel.Config{}
+	}
+	config.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	config.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	instrumentation, err := pinterestotel.New(config)
+	assert.Nil(t, err)
+	return instrumentation.Wrap(api), recorder, reader
+}
+
+// respond returns a RoundTripper that replies to every request with status.
+func respond(status int) http.RoundTripper {
+	return transport.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"X-Ratelimit-Remaining": {"99"}},
+			Body:       http.NoBody,
+			Request:    req,
+		}, nil
+	})
+}
+
+// send sends a request for the API call named operation through rt.
+func send(rt http.RoundTripper, operation string, url string) {
+	req, _ := http.NewRequest("GET", url, nil)
+	req = req.WithContext(transport.WithOperation(context.Background(), operation))
+	resp, err := rt.RoundTrip(req)
+	if err == nil {
+		resp.Body.Close()
+	}
+}
+
+// attributes returns the attributes of a span by key.
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, attr := range span.Attributes() {
+		attrs[attr.Key] = attr.Value
+	}
+	return attrs
+}
+
+// TestSpans tests that each request is traced with a client span named
+// after its API call, without the identifiers or query of its URL.
+func TestSpans(t *testing.T) {
+	rt, recorder, _ := instrument(t, respond(200), nil)
+	send(rt, "Pins.Fetch", "https://api.pinterest.com/v1/pins/813744226420795884/?access_token=secret")
+
+	spans := recorder.Ended()
+	assert.Equal(t, 1, len(spans))
+	assert.Equal(t, "pinterest.Pins.Fetch", spans[0].Name())
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	attrs := attributes(spans[0])
+	assert.Equal(t, "GET", attrs["http.request.method"].AsString())
+	assert.Equal(t, "Pins.Fetch", attrs["pinterest.operation"].AsString())
+	assert.Equal(t, "api.pinterest.com", attrs["server.address"].AsString())
+	assert.Equal(t, "/v1/pins/*/", attrs["url.path"].AsString())
+	assert.Equal(t, int64(200), attrs["http.response.status_code"].AsInt64())
+	for _, attr := range spans[0].Attributes() {
+		assert.NotContains(t, attr.Value.Emit(), "secret")
+		assert.NotContains(t, attr.Value.Emit(), "813744226420795884")
+	}
+
+	// Paths can be recorded in full
+	rt, recorder, _ = instrument(t, respond(200), &pinterestotel.Config{
+		Path: func(req *http.Request) string { return req.URL.Path },
+	})
+	send(rt, "Pins.Fetch", "https://api.pinterest.com/v5/pins/813744226420795884")
+	assert.Equal(t, "/v5/pins/813744226420795884", attributes(recorder.Ended()[0])["url.path"].AsString())
+}
+
+// TestSpanErrors tests that requests that fail, or return an error
+// status, are traced as errors.
+func TestSpanErrors(t *testing.T) {
+	rt, recorder, _ := instrument(t, respond(404), nil)
+	send(rt, "Boards.Fetch", "https://api.pinterest.com/v5/boards/1")
+	spans := recorder.Ended()
+	assert.Equal(t, 1, len(spans))
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "Not Found", spans[0].Status().Description)
+	assert.Equal(t, int64(404), attributes(spans[0])["http.response.status_code"].AsInt64())
+
+	rt, recorder, _ = instrument(t, transport.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	}), nil)
+	send(rt, "Boards.Fetch", "https://api.pinterest.com/v5/boards/1")
+	spans = recorder.Ended()
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "connection refused", spans[0].Status().Description)
+	assert.Equal(t, 1, len(spans[0].Events()))
+}
+
+// TestMetrics tests that requests are counted by outcome, and that the
+// rate limit remaining is recorded.
+func TestMetrics(t *testing.T) {
+	rt, _, reader := instrument(t, respond(429), nil)
+	send(rt, "Pins.Fetch", "https://api.pinterest.com/v5/pins/1")
+	send(rt, "Pins.Fetch", "https://api.pinterest.com/v5/pins/2")
+
+	var collected metricdata.ResourceMetrics
+	assert.Nil(t, reader.Collect(context.Background(), &collected))
+	metrics := map[string]metricdata.Aggregation{}
+	for _, scope := range collected.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+
+	for _, name := range []string{"pinterest.client.requests", "pinterest.client.errors"} {
+		sum, ok := metrics[name].(metricdata.Sum[int64])
+		assert.True(t, ok, name)
+		assert.Equal(t, 1, len(sum.DataPoints), name)
+		assert.Equal(t, int64(2), sum.DataPoints[0].Value, name)
+		errorType, _ := sum.DataPoints[0].Attributes.Value("error.type")
+		assert.Equal(t, "429", errorType.AsString(), name)
+		operation, _ := sum.DataPoints[0].Attributes.Value("pinterest.operation")
+		assert.Equal(t, "Pins.Fetch", operation.AsString(), name)
+	}
+	duration, ok := metrics["pinterest.client.request.duration"].(metricdata.Histogram[float64])
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), duration.DataPoints[0].Count)
+	remaining, ok := metrics["pinterest.client.ratelimit.remaining"].(metricdata.Gauge[int64])
+	assert.True(t, ok)
+	assert.Equal(t, int64(99), remaining.DataPoints[0].Value)
+}
@@ -0,0 +1,222 @@
+// Package pinterestprom exports the usage of the v1 and v5 Pinterest
+// clients as Prometheus metrics, so that you can alert when an
+// integration is failing, or close to exhausting its rate limit.
+//
+// Install a Collector on each client with Use, and
+// register it with Prometheus:
+//
+//	collector := pinterestprom.NewCollector(nil)
+//	prometheus.MustRegister(collector)
+//	client := v5.NewClient().
+//		RegisterAccessToken("USERS_ACCESS_TOKEN").
+//		Use(collector.Wrap)
+package pinterestprom
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/carrot/go-pinterest/transport"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Config is a struct that represents how the Collector measures requests
+type Config struct {
+	// Namespace prefixes the name of every metric.
+	// Defaults to "pinterest".
+	Namespace string
+
+	// DurationBuckets are the buckets of the request duration
+	// histogram, in seconds.  Defaults to prometheus.DefBuckets.
+	DurationBuckets []float64
+
+	// MaxTokens is how many access tokens the rate limit gauge keeps a
+	// series for.  The series of the token that was seen least recently
+	// is dropped to make room for a new one.  Defaults to 100.
+	MaxTokens int
+}
+
+// Collector is a prometheus.Collector that measures the requests of
+// the clients it is installed on:
+//
+//   - <namespace>_client_requests_total, by endpoint, method and status
+//   - <namespace>_client_request_duration_seconds, by endpoint and method
+//   - <namespace>_client_retries_total, by endpoint and method
+//   - <namespace>_client_ratelimit_remaining, by token
+//
+// The endpoint is the API call a request was sent for (such as
+// "Pins.Create"), or "other" for requests that weren't sent by one of the
+// clients' controllers.  The status is the response's status code, or
+// "error" if no response was received.
+//
+// Tokens are identified by a short SHA-256 fingerprint, rather than the
+// token itself.  As tokens are renewed, and each user of an integration
+// has their own, only the series of the Config's MaxTokens most recently
+// seen tokens are kept.
+type Collector struct {
+	requests           *prometheus.CounterVec
+	duration           *prometheus.HistogramVec
+	retries            *prometheus.CounterVec
+	rateLimitRemaining *prometheus.GaugeVec
+
+	maxTokens int
+	mutex     sync.Mutex
+	tokens    *list.List
+	tokenOf   map[string]*list.Element
+}
+
+// NewCollector instantiates a new Collector.
+func NewCollector(config *Config) *Collector {
+	// Default config
+	if config == nil {
+		config = &Config{}
+	}
+	namespace := config.Namespace
+	if namespace == "" {
+		namespace = "pinterest"
+	}
+	buckets := config.DurationBuckets
+	if buckets == nil {
+		buckets = prometheus.DefBuckets
+	}
+	maxTokens := config.MaxTokens
+	if maxTokens <= 0 {
+		maxTokens = 100
+	}
+
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "client",
+			Name:      "requests_total",
+			Help:      "Requests sent to the Pinterest API.",
+		}, []string{"endpoint", "method", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "client",
+			Name:      "request_duration_seconds",
+			Help:      "Time taken by requests to the Pinterest API, including retries.",
+			Buckets:   buckets,
+		}, []string{"endpoint", "method"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "client",
+			Name:      "retries_total",
+			Help:      "Requests to the Pinterest API that were retried.",
+		}, []string{"endpoint", "method"}),
+		rateLimitRemaining: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "client",
+			Name:      "ratelimit_remaining",
+			Help:      "The latest X-Ratelimit-Remaining returned by the Pinterest API.",
+		}, []string{"token"}),
+		maxTokens: maxTokens,
+		tokens:    list.New(),
+		tokenOf:   map[string]*list.Element{},
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.duration.Describe(ch)
+	c.retries.Describe(ch)
+	c.rateLimitRemaining.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.duration.Collect(ch)
+	c.retries.Collect(ch)
+	c.rateLimitRemaining.Collect(ch)
+}
+
+// Wrap returns a RoundTripper that measures the requests sent
+// through base.  Pass it to a client's Use.
+func (c *Collector) Wrap(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &roundTripper{collector: c, base: base}
+}
+
+// roundTripper is the http.RoundTripper returned by Wrap.
+type roundTripper struct {
+	collector *Collector
+	base      http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	c := rt.collector
+	endpoint := transport.Operation(req.Context())
+	if endpoint == "" {
+		endpoint = "other"
+	}
+
+	// Count retries as they happen
+	ctx := transport.WithRetryObserver(req.Context(), func(*http.Request, int, *http.Response, error) {
+		c.retries.WithLabelValues(endpoint, req.Method).Inc()
+	})
+
+	// Send request
+	start := time.Now()
+	resp, err := rt.base.RoundTrip(req.WithContext(ctx))
+	c.duration.WithLabelValues(endpoint, req.Method).Observe(time.Since(start).Seconds())
+
+	// Record outcome
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(resp.StatusCode)
+		if remaining, err := strconv.ParseFloat(resp.Header.Get("X-Ratelimit-Remaining"), 64); err == nil {
+			c.setRateLimitRemaining(tokenFingerprint(resp.Request), remaining)
+		}
+	}
+	c.requests.WithLabelValues(endpoint, req.Method, status).Inc()
+	return resp, err
+}
+
+// setRateLimitRemaining records the rate limit remaining of a token,
+// dropping the series of the least recently seen token if there are
+// more than maxTokens.
+func (c *Collector) setRateLimitRemaining(token string, remaining float64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.tokenOf[token]; ok {
+		c.tokens.MoveToFront(element)
+	} else {
+		c.tokenOf[token] = c.tokens.PushFront(token)
+	}
+	for c.tokens.Len() > c.maxTokens {
+		oldest := c.tokens.Remove(c.tokens.Back()).(string)
+		delete(c.tokenOf, oldest)
+		c.rateLimitRemaining.DeleteLabelValues(oldest)
+	}
+	c.rateLimitRemaining.WithLabelValues(token).Set(remaining)
+}
+
+// tokenFingerprint identifies the access token that authorized req (a v5
+// Bearer token, or a v1 access_token parameter) without revealing it.
+// req is the request that was sent, after it was authorized.
+func tokenFingerprint(req *http.Request) string {
+	if req == nil {
+		return "none"
+	}
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if token == "" {
+		token = req.URL.Query().Get("access_token")
+	}
+	if token == "" {
+		return "none"
+	}
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:4])
+}
@@ -0,0 +1,110 @@
+package pinterestprom_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/carrot/go-pinterest/pinterestprom"
+	"github.com/carrot/go-pinterest/transport"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAPI replies to each request with the next of its status codes,
+// and fails the request once it has none left.
+type fakeAPI struct {
+	statusCodes []int
+}
+
+func (fa *fakeAPI) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(fa.statusCodes) == 0 {
+		return nil, errors.New("connection refused")
+	}
+	statusCode := fa.statusCodes[0]
+	fa.statusCodes = fa.statusCodes[1:]
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{"X-Ratelimit-Remaining": {"42"}},
+		Body:       http.NoBody,
+		Request:    req,
+	}, nil
+}
+
+// send sends a request for the operation, authorized with token.
+func send(rt http.RoundTripper, operation string, token string) {
+	ctx := context.Background()
+	if operation != "" {
+		ctx = transport.WithOperation(ctx, operation)
+	}
+	req, _ := http.NewRequestWithContext(ctx, "GET", "https://api.pinterest.com/v5/pins/1", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rt.RoundTrip(req)
+}
+
+// TestCollector tests that requests are counted by endpoint and status,
+// that retries are counted, and that the rate limit of each token is
+// recorded.
+func TestCollector(t *testing.T) {
+	collector := pinterestprom.NewCollector(nil)
+	api := &fakeAPI{statusCodes: []int{200, 404, 429, 200}}
+	rt := collector.Wrap(&transport.Retry{
+		Policy: transport.RetryPolicy{MaxAttempts: 2, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+		Base:   api,
+	})
+
+	send(rt, "Pins.Fetch", "first-token")
+	send(rt, "Pins.Fetch", "first-token")
+	send(rt, "Boards.Fetch", "first-token")
+	send(rt, "", "first-token")
+
+	requests := `
+		# HELP pinterest_client_requests_total Requests sent to the Pinterest API.
+		# TYPE pinterest_client_requests_total counter
+		pinterest_client_requests_total{endpoint="Boards.Fetch",method="GET",status="200"} 1
+		pinterest_client_requests_total{endpoint="Pins.Fetch",method="GET",status="200"} 1
+		pinterest_client_requests_total{endpoint="Pins.Fetch",method="GET",status="404"} 1
+		pinterest_client_requests_total{endpoint="other",method="GET",status="error"} 1
+	`
+	assert.Nil(t, testutil.CollectAndCompare(collector, strings.NewReader(requests), "pinterest_client_requests_total"))
+
+	retries := `
+		# HELP pinterest_client_retries_total Requests to the Pinterest API that were retried.
+		# TYPE pinterest_client_retries_total counter
+		pinterest_client_retries_total{endpoint="Boards.Fetch",method="GET"} 1
+		pinterest_client_retries_total{endpoint="other",method="GET"} 1
+	`
+	assert.Nil(t, testutil.CollectAndCompare(collector, strings.NewReader(retries), "pinterest_client_retries_total"))
+
+	// The token is only identified by its fingerprint
+	assert.Equal(t, 1, testutil.CollectAndCount(collector, "pinterest_client_ratelimit_remaining"))
+	gauge := `
+		# HELP pinterest_client_ratelimit_remaining The latest X-Ratelimit-Remaining returned by the Pinterest API.
+		# TYPE pinterest_client_ratelimit_remaining gauge
+		pinterest_client_ratelimit_remaining{token="55b4b48f"} 42
+	`
+	assert.Nil(t, testutil.CollectAndCompare(collector, strings.NewReader(gauge), "pinterest_client_ratelimit_remaining"))
+}
+
+// TestCollectorMaxTokens tests that only the series of the most
+// recently seen tokens are kept.
+func TestCollectorMaxTokens(t *testing.T) {
+	collector := pinterestprom.NewCollector(&pinterestprom.Config{MaxTokens: 2})
+	api := &fakeAPI{statusCodes: []int{200, 200, 200, 200}}
+	rt := collector.Wrap(api)
+
+	send(rt, "Pins.Fetch", "first-token")
+	send(rt, "Pins.Fetch", "second-token")
+	send(rt, "Pins.Fetch", "first-token")
+	send(rt, "Pins.Fetch", "third-token")
+	gauge := `
+		# HELP pinterest_client_ratelimit_remaining The latest X-Ratelimit-Remaining returned by the Pinterest API.
+		# TYPE pinterest_client_ratelimit_remaining gauge
+		pinterest_client_ratelimit_remaining{token="55b4b48f"} 42
+		pinterest_client_ratelimit_remaining{token="4805ab06"} 42
+	`
+	assert.Nil(t, testutil.CollectAndCompare(collector, strings.NewReader(gauge), "pinterest_client_ratelimit_remaining"))
+}
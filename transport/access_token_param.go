@@ -0,0 +1,31 @@
+package transport
+
+import (
+	"net/http"
+)
+
+// AccessTokenParam is an http.RoundTripper that authorizes every request
+// with an access_token query string parameter, as the v1 API expects.
+// Requests that already have one are sent as they are.
+type AccessTokenParam struct {
+	// Token is the access token that requests are authorized with.
+	Token string
+
+	// Base is the RoundTripper that authorized requests are sent with.
+	// Defaults to http.DefaultTransport.
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (atp *AccessTokenParam) RoundTrip(req *http.Request) (*http.Response, error) {
+	query := req.URL.Query()
+	if query.Get("access_token") != "" {
+		return base(atp.Base).RoundTrip(req)
+	}
+	query.Set("access_token", atp.Token)
+	authorized := cloneRequest(req)
+	authorizedURL := *req.URL
+	authorizedURL.RawQuery = query.Encode()
+	authorized.URL = &authorizedURL
+	return base(atp.Base).RoundTrip(authorized)
+}
@@ -0,0 +1,27 @@
+package transport
+
+import (
+	"net/http"
+)
+
+// BearerAuth is an http.RoundTripper that authorizes every request
+// with an OAuth bearer token.  Requests that are already authorized
+// (such as OAuth token requests) are sent as they are.
+type BearerAuth struct {
+	// Token is the access token that requests are authorized with.
+	Token string
+
+	// Base is the RoundTripper that authorized requests are sent with.
+	// Defaults to http.DefaultTransport.
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (ba *BearerAuth) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Authorization") != "" {
+		return base(ba.Base).RoundTrip(req)
+	}
+	authorized := cloneRequest(req)
+	authorized.Header.Set("Authorization", "Bearer "+ba.Token)
+	return base(ba.Base).RoundTrip(authorized)
+}
@@ -0,0 +1,140 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// Coalesce is an http.RoundTripper that sends identical GET requests only
+// once while one is in flight: requests for the same URL (including its
+// query string) with the same Authorization header share a single request,
+// and each gets its own copy of its response.
+//
+// Coalesce must be installed beneath authorization, so that requests of
+// different users are never coalesced.  The shared request isn't bound to
+// the context of any of the requests sharing it: each stops waiting when
+// its own context is done, and the shared request is only canceled once
+// none of them are left waiting.  If the shared request fails, every
+// request waiting on it fails with the same error.
+type Coalesce struct {
+	// Base is the RoundTripper that requests are sent with.
+	// Defaults to http.DefaultTransport.
+	Base http.RoundTripper
+
+	mutex sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+// CoalesceObserver is called with a request that waits for an identical
+// request in flight, rather than being sent.
+type CoalesceObserver func(req *http.Request)
+
+// coalesceObserverKey is the context key that holds a CoalesceObserver.
+type coalesceObserverKey struct{}
+
+// WithCoalesceObserver returns a copy of ctx whose requests call observe
+// when they wait for an identical request in flight, so that a RoundTripper
+// layered above Coalesce (such as one measuring requests) can tell which
+// requests weren't sent.
+func WithCoalesceObserver(ctx context.Context, observe CoalesceObserver) context.Context {
+	return context.WithValue(ctx, coalesceObserverKey{}, observe)
+}
+
+// coalescedCall is a request in flight, and its outcome once done
+// is closed.
+type coalescedCall struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiting int
+	resp    *http.Response
+	body    []byte
+	err     error
+}
+
+// RoundTrip implements http.RoundTripper.
+func (c *Coalesce) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != "GET" {
+		return base(c.Base).RoundTrip(req)
+	}
+	key := req.URL.String() + " " + req.Header.Get("Authorization")
+
+	// Join an identical request in flight, or send one that
+	// outlives the context of req
+	c.mutex.Lock()
+	call, joined := c.calls[key]
+	if !joined {
+		ctx, cancel := context.WithCancel(context.WithoutCancel(req.Context()))
+		call = &coalescedCall{done: make(chan struct{}), cancel: cancel}
+		if c.calls == nil {
+			c.calls = map[string]*coalescedCall{}
+		}
+		c.calls[key] = call
+		go c.send(key, call, req.WithContext(ctx))
+	}
+	call.waiting++
+	c.mutex.Unlock()
+	if observe, ok := req.Context().Value(coalesceObserverKey{}).(CoalesceObserver); ok && joined {
+		observe(req)
+	}
+
+	// Wait for it, unless req is canceled first
+	select {
+	case <-call.done:
+		return call.response(req)
+	case <-req.Context().Done():
+		c.leave(key, call)
+		return nil, req.Context().Err()
+	}
+}
+
+// send sends the call's request, reading its body so it can be shared.
+func (c *Coalesce) send(key string, call *coalescedCall, req *http.Request) {
+	call.resp, call.err = base(c.Base).RoundTrip(req)
+	if call.err == nil {
+		call.body, call.err = ioutil.ReadAll(call.resp.Body)
+		call.resp.Body.Close()
+	}
+
+	c.mutex.Lock()
+	if c.calls[key] == call {
+		delete(c.calls, key)
+	}
+	c.mutex.Unlock()
+	call.cancel()
+	close(call.done)
+}
+
+// leave stops a request waiting on the call, canceling the call
+// if no other request is waiting on it.
+func (c *Coalesce) leave(key string, call *coalescedCall) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	call.waiting--
+	if call.waiting > 0 {
+		return
+	}
+
+	// Requests that come after are sent again, rather than
+	// failing with the canceled call
+	if c.calls[key] == call {
+		delete(c.calls, key)
+	}
+	call.cancel()
+}
+
+// response returns a copy of the call's response, as a reply to req.
+func (call *coalescedCall) response(req *http.Request) (*http.Response, error) {
+	if call.err != nil {
+		return nil, call.err
+	}
+	resp := new(http.Response)
+	*resp = *call.resp
+	resp.Header = call.resp.Header.Clone()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(call.body))
+	resp.ContentLength = int64(len(call.body))
+	resp.Request = req
+	return resp, nil
+}
@@ -0,0 +1,139 @@
+package transport_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/carrot/go-pinterest/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+// gatedAPI signals each request it receives on started, and holds it
+// until its gate is opened (replying with a 200) or it is canceled.
+type gatedAPI struct {
+	gate    chan struct{}
+	started chan *http.Request
+}
+
+func (ga *gatedAPI) RoundTrip(req *http.Request) (*http.Response, error) {
+	ga.started <- req
+	select {
+	case <-ga.gate:
+		return &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"id": "1"}`)),
+			Request:    req,
+		}, nil
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+}
+
+// newGatedAPI returns a gatedAPI whose gate is closed.
+func newGatedAPI() *gatedAPI {
+	return &gatedAPI{gate: make(chan struct{}), started: make(chan *http.Request, 10)}
+}
+
+// fetch sends a request for a pin with ctx, and reports on result
+// the error it failed with, if it didn't get the pin.
+func fetch(ctx context.Context, rt http.RoundTripper, result chan<- error) {
+	req, _ := http.NewRequestWithContext(ctx, "GET", "https://api.pinterest.com/v5/pins/1", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := rt.RoundTrip(req)
+	if err == nil {
+		var body []byte
+		body, err = ioutil.ReadAll(resp.Body)
+		if string(body) != `{"id": "1"}` {
+			err = assert.AnError
+		}
+	}
+	result <- err
+}
+
+// TestCoalesce tests that identical requests in flight at the same
+// time are sent once.
+func TestCoalesce(t *testing.T) {
+	api := newGatedAPI()
+	rt := &transport.Coalesce{Base: api}
+	waiting := make(chan struct{}, 10)
+	ctx := transport.WithCoalesceObserver(context.Background(), func(*http.Request) {
+		waiting <- struct{}{}
+	})
+
+	// Hold the first request until the others are waiting for it
+	results := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		go fetch(ctx, rt, results)
+	}
+	<-api.started
+	for i := 0; i < 4; i++ {
+		<-waiting
+	}
+	close(api.gate)
+	for i := 0; i < 5; i++ {
+		assert.Nil(t, <-results)
+	}
+	assert.Len(t, api.started, 0)
+
+	// Requests that come after are sent again
+	go fetch(ctx, rt, results)
+	<-api.started
+	assert.Nil(t, <-results)
+}
+
+// TestCoalesceCanceled tests that the shared request isn't canceled
+// with the request that sent it, but only once no request is
+// waiting for it.
+func TestCoalesceCanceled(t *testing.T) {
+	api := newGatedAPI()
+	rt := &transport.Coalesce{Base: api}
+	waiting := make(chan struct{}, 10)
+	observed := transport.WithCoalesceObserver(context.Background(), func(*http.Request) {
+		waiting <- struct{}{}
+	})
+
+	// The first request is canceled, and the second still gets its response
+	firstCtx, cancelFirst := context.WithCancel(observed)
+	first := make(chan error, 1)
+	go fetch(firstCtx, rt, first)
+	shared := <-api.started
+	second := make(chan error, 1)
+	go fetch(observed, rt, second)
+	<-waiting
+	cancelFirst()
+	assert.Equal(t, context.Canceled, <-first)
+	assert.Nil(t, shared.Context().Err())
+	close(api.gate)
+	assert.Nil(t, <-second)
+
+	// Once every request is canceled, so is the shared one
+	api = newGatedAPI()
+	rt = &transport.Coalesce{Base: api}
+	var cancels []context.CancelFunc
+	results := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithCancel(observed)
+		cancels = append(cancels, cancel)
+		go fetch(ctx, rt, results)
+	}
+	shared = <-api.started
+	<-waiting
+	for _, cancel := range cancels {
+		cancel()
+	}
+	for i := 0; i < 2; i++ {
+		assert.Equal(t, context.Canceled, <-results)
+	}
+	<-shared.Context().Done()
+
+	// A request that comes after is sent again, rather than
+	// failing with the canceled one
+	close(api.gate)
+	go fetch(context.Background(), rt, results)
+	<-api.started
+	assert.Nil(t, <-results)
+}
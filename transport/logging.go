@@ -0,0 +1,124 @@
+package transport
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogLevels are the levels that Logging logs requests at,
+// depending on their outcome.
+type LogLevels struct {
+	// Success is the level of requests that succeeded.
+	Success slog.Level
+
+	// ClientError is the level of requests that failed with a 4xx
+	// status, such as a 404 or being rate limited.
+	ClientError slog.Level
+
+	// ServerError is the level of requests that failed with a 5xx
+	// status, or that could not be sent at all.
+	ServerError slog.Level
+}
+
+// DefaultLogLevels logs successful requests at the debug level,
+// and failed requests as warnings or errors.
+var DefaultLogLevels = LogLevels{
+	Success:     slog.LevelDebug,
+	ClientError: slog.LevelWarn,
+	ServerError: slog.LevelError,
+}
+
+// Logging is an http.RoundTripper that logs each request that is sent,
+// with its method, operation (see WithOperation), path, status, latency
+// and the rate limit remaining.
+//
+// The query string is never logged, as it can hold credentials (such as
+// a v1 access token or a signed upload URL), and by default neither are
+// the identifiers in the path (see RedactPath).  When Logging is installed
+// beneath Retry, each attempt is logged along with its number.
+type Logging struct {
+	Logger *slog.Logger
+	Levels LogLevels
+
+	// Path, if set, returns the path that is logged for a request, such
+	// as to log paths in full.  Defaults to RedactPath.
+	Path func(req *http.Request) string
+
+	// Base is the RoundTripper that requests are sent with.
+	// Defaults to http.DefaultTransport.
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (l *Logging) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := base(l.Base).RoundTrip(req)
+
+	path := RedactPath
+	if l.Path != nil {
+		path = l.Path
+	}
+	level := l.Levels.Success
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", path(req)),
+		slog.Duration("latency", time.Since(start)),
+	}
+	if operation := Operation(req.Context()); operation != "" {
+		attrs = append(attrs, slog.String("operation", operation))
+	}
+	if attempt := attemptOf(req); attempt > 1 {
+		attrs = append(attrs, slog.Int("attempt", attempt))
+	}
+	if err != nil {
+		level = l.Levels.ServerError
+		attrs = append(attrs, slog.String("error", err.Error()))
+	} else {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		if remaining, err := strconv.Atoi(resp.Header.Get("X-Ratelimit-Remaining")); err == nil {
+			attrs = append(attrs, slog.Int("ratelimit_remaining", remaining))
+		}
+		switch {
+		case resp.StatusCode >= 500:
+			level = l.Levels.ServerError
+		case resp.StatusCode >= 400:
+			level = l.Levels.ClientError
+		}
+	}
+
+	l.Logger.LogAttrs(req.Context(), level, "pinterest request", attrs...)
+	return resp, err
+}
+
+// RedactPath returns the path of req with every segment after the API
+// version and resource replaced by "*" (such as "/v5/pins/*"), as those
+// are identifiers of users, boards and pins.
+func RedactPath(req *http.Request) string {
+	segments := strings.Split(req.URL.Path, "/")
+	for i := 3; i < len(segments); i++ {
+		if segments[i] != "" {
+			segments[i] = "*"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// attemptKey is the context key that holds the attempt number of a request.
+type attemptKey struct{}
+
+// withAttempt returns a copy of req that records its attempt number.
+func withAttempt(req *http.Request, attempt int) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), attemptKey{}, attempt))
+}
+
+// attemptOf returns the attempt number of req, starting at 1.
+func attemptOf(req *http.Request) int {
+	if attempt, ok := req.Context().Value(attemptKey{}).(int); ok {
+		return attempt
+	}
+	return 1
+}
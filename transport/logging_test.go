@@ -0,0 +1,25 @@
+package transport_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/carrot/go-pinterest/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRedactPath tests that only the API version and resource
+// of a path are logged.
+func TestRedactPath(t *testing.T) {
+	cases := map[string]string{
+		"https://api.pinterest.com/v5/user_account":                  "/v5/user_account",
+		"https://api.pinterest.com/v5/pins/813744226420795884":       "/v5/pins/*",
+		"https://api.pinterest.com/v1/boards/gopher/recipes/pins/":   "/v1/boards/*/*/*/",
+		"https://api.pinterest.com/v1/users/gopher/?access_token=xy": "/v1/users/*/",
+	}
+	for url, expected := range cases {
+		req, err := http.NewRequest("GET", url, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, transport.RedactPath(req))
+	}
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+)
+
+// Middleware wraps the RoundTripper that a client's requests are sent
+// with, returning a RoundTripper that sees each request before it is sent
+// and its response (or error) after.  It must send requests with next,
+// which is never nil.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc is a function that implements http.RoundTripper,
+// to write a Middleware as a closure.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Chain layers middleware over rt, in order: the first middleware is the
+// outermost, so it is the first to see each request and the last to see
+// its response.  A nil rt stands for http.DefaultTransport.
+func Chain(rt http.RoundTripper, middleware ...Middleware) http.RoundTripper {
+	if len(middleware) == 0 {
+		return rt
+	}
+	chained := base(rt)
+	for i := len(middleware) - 1; i >= 0; i-- {
+		chained = middleware[i](chained)
+	}
+	return chained
+}
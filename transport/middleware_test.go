@@ -0,0 +1,38 @@
+package transport_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/carrot/go-pinterest/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestChain tests that middleware is layered in order around each
+// request, the first being the outermost.
+func TestChain(t *testing.T) {
+	var calls []string
+	tracing := func(name string) transport.Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return transport.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" request")
+				resp, err := next.RoundTrip(req)
+				calls = append(calls, name+" response")
+				return resp, err
+			})
+		}
+	}
+	api := transport.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		calls = append(calls, "sent")
+		return &http.Response{StatusCode: 200, Body: http.NoBody, Request: req}, nil
+	})
+
+	req, _ := http.NewRequest("GET", "https://api.pinterest.com/v5/user_account", nil)
+	_, err := transport.Chain(api, tracing("first"), tracing("second")).RoundTrip(req)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"first request", "second request", "sent", "second response", "first response"}, calls)
+
+	// Without middleware, the RoundTripper is used as it is
+	stub := &stubTransport{}
+	assert.Same(t, stub, transport.Chain(stub))
+}
@@ -0,0 +1,65 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+)
+
+// operationKey is the context key that holds the name of the API call
+// that a request is sent for.
+type operationKey struct{}
+
+// WithOperation returns a copy of ctx that names the API call (such as
+// "Pins.Create") that requests sent with it are for.  The v1 and v5
+// controllers name every request they send.
+func WithOperation(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationKey{}, name)
+}
+
+// Operation returns the name of the API call that requests
+// sent with ctx are for, or "" if it wasn't named.
+func Operation(ctx context.Context) string {
+	name, _ := ctx.Value(operationKey{}).(string)
+	return name
+}
+
+// Scope is an http.RoundTripper that sends requests with a context of
+// its own, and names the API call they are sent for, on behalf of HTTP
+// clients (such as wrecker, which the v1 client is built on) that don't
+// let their callers set a request's context.
+type Scope struct {
+	// Context, if set, is the context that requests are sent with, in
+	// place of their own.  The deadline of a request's own context (such
+	// as the one set by http.Client's Timeout) still applies.
+	Context context.Context
+
+	// Operation, if set, names the API call that requests are sent for.
+	Operation string
+
+	// Base is the RoundTripper that requests are sent with.
+	// Defaults to http.DefaultTransport.
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (s *Scope) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	cancel := context.CancelFunc(func() {})
+	if s.Context != nil {
+		ctx = s.Context
+		if deadline, ok := req.Context().Deadline(); ok {
+			ctx, cancel = context.WithDeadline(ctx, deadline)
+		}
+	}
+	if s.Operation != "" {
+		ctx = WithOperation(ctx, s.Operation)
+	}
+
+	resp, err := base(s.Base).RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
@@ -0,0 +1,41 @@
+package transport_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/carrot/go-pinterest/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestScope tests that requests are sent with the Scope's context and
+// operation, keeping the deadline of their own context.
+func TestScope(t *testing.T) {
+	type traceKey struct{}
+	var sent *http.Request
+	scope := &transport.Scope{
+		Context:   context.WithValue(context.Background(), traceKey{}, "parent-span"),
+		Operation: "Pins.Fetch",
+		Base: transport.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			sent = req
+			return &http.Response{StatusCode: 200, Body: http.NoBody, Request: req}, nil
+		}),
+	}
+
+	deadline := time.Now().Add(time.Minute)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", "https://api.pinterest.com/v1/pins/1/", nil)
+	resp, err := scope.RoundTrip(req)
+	assert.Nil(t, err)
+	assert.Nil(t, resp.Body.Close())
+
+	assert.Equal(t, "parent-span", sent.Context().Value(traceKey{}))
+	assert.Equal(t, "Pins.Fetch", transport.Operation(sent.Context()))
+	sentDeadline, ok := sent.Context().Deadline()
+	assert.True(t, ok)
+	assert.Equal(t, deadline, sentDeadline)
+	assert.NotNil(t, sent.Context().Err())
+}
@@ -0,0 +1,66 @@
+package transport
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// TokenSource supplies the access tokens that RefreshingBearerAuth
+// authorizes requests with.
+type TokenSource interface {
+	// Token returns the access token to authorize a request with,
+	// renewing it first if it is about to expire.
+	Token() (string, error)
+
+	// Refresh renews the access token after the API rejected stale.
+	// If the token has already been renewed since stale was returned,
+	// the current token is returned instead of renewing it again.
+	Refresh(stale string) (string, error)
+}
+
+// RefreshingBearerAuth is an http.RoundTripper that authorizes every
+// request with an OAuth bearer token from a TokenSource.
+//
+// If the API rejects a token as unauthorized (401), the token is refreshed
+// and the request is sent once more, provided its body can be rewound.
+type RefreshingBearerAuth struct {
+	Source TokenSource
+
+	// Base is the RoundTripper that authorized requests are sent with.
+	// Defaults to http.DefaultTransport.
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rba *RefreshingBearerAuth) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Requests that are already authorized are sent as they are
+	if req.Header.Get("Authorization") != "" {
+		return base(rba.Base).RoundTrip(req)
+	}
+
+	token, err := rba.Source.Token()
+	if err != nil {
+		return nil, err
+	}
+	authorized := cloneRequest(req)
+	authorized.Header.Set("Authorization", "Bearer "+token)
+	resp, err := base(rba.Base).RoundTrip(authorized)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// Refresh the rejected token, and try once more
+	next, ok := rewindRequest(req)
+	if !ok {
+		return resp, nil
+	}
+	refreshed, err := rba.Source.Refresh(token)
+	if err != nil || refreshed == token {
+		return resp, nil
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	next.Header.Set("Authorization", "Bearer "+refreshed)
+	return base(rba.Base).RoundTrip(next)
+}
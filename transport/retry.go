@@ -0,0 +1,206 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes how failed requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent,
+	// including the first attempt.  Values below 2 disable retries.
+	MaxAttempts int
+
+	// MinBackoff is how long to wait before the first retry.  The wait
+	// doubles for each following retry, up to MaxBackoff.  Defaults to
+	// the MinBackoff of DefaultRetryPolicy.
+	MinBackoff time.Duration
+
+	// MaxBackoff caps how long to wait between two attempts, including
+	// waits requested by the API through a Retry-After header.  Defaults
+	// to the MaxBackoff of DefaultRetryPolicy.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is a reasonable RetryPolicy for most integrations.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  30 * time.Second,
+}
+
+// Retry is an http.RoundTripper that retries requests that were
+// rate limited (429), or that failed with a transient server error or
+// network error.
+//
+// Requests that aren't idempotent (such as a POST creating a pin) are only
+// retried when they were rate limited, as Pinterest guarantees those were
+// not processed.
+type Retry struct {
+	Policy RetryPolicy
+
+	// Base is the RoundTripper that each attempt is sent with.
+	// Defaults to http.DefaultTransport.
+	Base http.RoundTripper
+
+	// OnRetry, if set, is called before waiting to retry a request.
+	// attempt is the number of the attempt that failed, starting at 1.
+	OnRetry func(req *http.Request, attempt int, resp *http.Response, err error)
+
+	// AttemptTimeout, if set, limits how long each attempt may take,
+	// including reading its response body, like http.Client's Timeout
+	// does for a whole request.  The waits between attempts aren't
+	// limited by it, so an http.Client wrapping Retry should not set a
+	// Timeout of its own, which would cut the retries short.
+	AttemptTimeout time.Duration
+}
+
+// RetryObserver is called before waiting to retry a request,
+// with the same arguments as Retry's OnRetry.
+type RetryObserver func(req *http.Request, attempt int, resp *http.Response, err error)
+
+// retryObserverKey is the context key that holds a RetryObserver.
+type retryObserverKey struct{}
+
+// WithRetryObserver returns a copy of ctx whose requests call observe each
+// time they are retried, so that a RoundTripper layered above Retry (such
+// as one measuring requests) can tell how many times they were retried.
+func WithRetryObserver(ctx context.Context, observe RetryObserver) context.Context {
+	return context.WithValue(ctx, retryObserverKey{}, observe)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Retry) RoundTrip(req *http.Request) (*http.Response, error) {
+	attemptReq := req
+	for attempt := 1; ; attempt++ {
+		resp, err := r.send(withAttempt(attemptReq, attempt))
+		if attempt >= r.Policy.MaxAttempts || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+		next, ok := rewindRequest(req)
+		if !ok {
+			return resp, err
+		}
+
+		// Discard the failed response, so the connection can be reused
+		wait := r.Policy.backoff(attempt, resp)
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if r.OnRetry != nil {
+			r.OnRetry(req, attempt, resp, err)
+		}
+		if observe, ok := req.Context().Value(retryObserverKey{}).(RetryObserver); ok {
+			observe(req, attempt, resp, err)
+		}
+
+		// Wait, unless the request is canceled first
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+		attemptReq = next
+	}
+}
+
+// send sends a single attempt, limited to AttemptTimeout.
+func (r *Retry) send(req *http.Request) (*http.Response, error) {
+	if r.AttemptTimeout <= 0 {
+		return base(r.Base).RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), r.AttemptTimeout)
+	resp, err := base(r.Base).RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelBody is a response body that releases the context of its
+// attempt once it is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close implements io.Closer.
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// shouldRetry returns true if the outcome of an attempt is worth retrying.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(req) && req.Context().Err() == nil
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req)
+	}
+	return false
+}
+
+// isIdempotent returns true if sending req twice has the same effect as
+// sending it once.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// rewindRequest returns a copy of req that can be sent again,
+// or false if req's body can't be read a second time.
+func rewindRequest(req *http.Request) (*http.Request, bool) {
+	next := cloneRequest(req)
+	if req.Body == nil || req.Body == http.NoBody {
+		return next, true
+	}
+	if req.GetBody == nil {
+		return nil, false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+	next.Body = body
+	return next, true
+}
+
+// backoff returns how long to wait after the failed attempt.
+func (rp RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	// Unset backoffs would retry without waiting at all
+	if rp.MinBackoff <= 0 {
+		rp.MinBackoff = DefaultRetryPolicy.MinBackoff
+	}
+	if rp.MaxBackoff <= 0 {
+		rp.MaxBackoff = DefaultRetryPolicy.MaxBackoff
+	}
+
+	wait := rp.MinBackoff << uint(attempt-1)
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			wait = time.Duration(seconds) * time.Second
+		}
+	}
+	if wait > rp.MaxBackoff || wait < 0 {
+		wait = rp.MaxBackoff
+	}
+	return wait
+}
@@ -0,0 +1,107 @@
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/carrot/go-pinterest/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubTransport replies to each request with the next of its responses,
+// or waits for the request to be canceled when it has none left.
+type stubTransport struct {
+	statusCodes []int
+	requests    []*http.Request
+}
+
+func (st *stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	st.requests = append(st.requests, req)
+	if len(st.statusCodes) == 0 {
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}
+	statusCode := st.statusCodes[0]
+	st.statusCodes = st.statusCodes[1:]
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(`{}`)),
+		Request:    req,
+	}, nil
+}
+
+var testPolicy = transport.RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  time.Millisecond,
+	MaxBackoff:  time.Millisecond,
+}
+
+// TestRetry tests which outcomes are retried.
+func TestRetry(t *testing.T) {
+	stub := &stubTransport{statusCodes: []int{429, 503, 200}}
+	var retried []int
+	client := &http.Client{Transport: &transport.Retry{
+		Policy: testPolicy,
+		Base:   stub,
+		OnRetry: func(req *http.Request, attempt int, resp *http.Response, err error) {
+			retried = append(retried, resp.StatusCode)
+		},
+	}}
+	resp, err := client.Get("https://api.pinterest.com/v5/user_account")
+	assert.Nil(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Equal(t, []int{429, 503}, retried)
+
+	// A POST is only retried when it was rate limited
+	stub = &stubTransport{statusCodes: []int{503, 200}}
+	client.Transport = &transport.Retry{Policy: testPolicy, Base: stub}
+	resp, err = client.Post("https://api.pinterest.com/v5/pins", "application/json", strings.NewReader(`{}`))
+	assert.Nil(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Equal(t, 1, len(stub.requests))
+}
+
+// TestRetryAttemptTimeout tests that an attempt that takes longer than
+// AttemptTimeout is retried, and that its response body can still be
+// read once an attempt succeeds.
+func TestRetryAttemptTimeout(t *testing.T) {
+	stub := &stubTransport{}
+	client := &http.Client{Transport: &transport.Retry{
+		Policy:         testPolicy,
+		Base:           stub,
+		AttemptTimeout: 10 * time.Millisecond,
+	}}
+	_, err := client.Get("https://api.pinterest.com/v5/user_account")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, 3, len(stub.requests))
+
+	stub = &stubTransport{statusCodes: []int{200}}
+	client.Transport = &transport.Retry{Policy: testPolicy, Base: stub, AttemptTimeout: time.Second}
+	resp, err := client.Get("https://api.pinterest.com/v5/user_account")
+	assert.Nil(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, `{}`, string(body))
+	assert.Nil(t, resp.Body.Close())
+}
+
+// TestRetryUnsetMaxBackoff tests that a policy without a MaxBackoff
+// still waits between attempts, rather than retrying at once.
+func TestRetryUnsetMaxBackoff(t *testing.T) {
+	stub := &stubTransport{statusCodes: []int{429, 503, 200}}
+	client := &http.Client{Transport: &transport.Retry{
+		Policy: transport.RetryPolicy{MaxAttempts: 3, MinBackoff: 20 * time.Millisecond},
+		Base:   stub,
+	}}
+	start := time.Now()
+	resp, err := client.Get("https://api.pinterest.com/v5/user_account")
+	assert.Nil(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.True(t, time.Since(start) >= 60*time.Millisecond)
+}
@@ -0,0 +1,31 @@
+// Package transport contains the http.RoundTrippers that are shared by
+// the v1 and v5 Pinterest clients, such as authorization and retries.
+//
+// You won't usually need to use this package directly, as the clients
+// install these for you, but you may use them to build your own
+// http.Client for the Pinterest API.
+package transport
+
+import (
+	"net/http"
+)
+
+// base returns rt, or http.DefaultTransport if rt is nil.
+func base(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		return http.DefaultTransport
+	}
+	return rt
+}
+
+// cloneRequest returns a shallow copy of req with a deep copy of its
+// headers, as a RoundTripper must not modify the request it is given.
+func cloneRequest(req *http.Request) *http.Request {
+	clone := new(http.Request)
+	*clone = *req
+	clone.Header = make(http.Header, len(req.Header))
+	for key, values := range req.Header {
+		clone.Header[key] = append([]string(nil), values...)
+	}
+	return clone
+}
@@ -0,0 +1,224 @@
+package controllers
+
+import (
+	"time"
+
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// AdAccountsAdGroupsController is the controller that is responsible for all
+// /v5/ad_accounts/<ad_account_id>/ad_groups endpoints in the Pinterest API.
+type AdAccountsAdGroupsController struct {
+	restClient *rest.Client
+}
+
+// newAdAccountsAdGroupsController instantiates a new AdAccountsAdGroupsController.
+func newAdAccountsAdGroupsController(rc *rest.Client) *AdAccountsAdGroupsController {
+	return &AdAccountsAdGroupsController{
+		restClient: rc,
+	}
+}
+
+// AdAccountsAdGroupsListOptionals is a struct that represents the optional parameters
+// that can be passed to the List endpoint
+type AdAccountsAdGroupsListOptionals struct {
+	Bookmark       string
+	PageSize       int
+	CampaignIds    []string
+	AdGroupIds     []string
+	EntityStatuses []string
+}
+
+// List loads the ad groups of an ad account
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/ad_groups
+func (agc *AdAccountsAdGroupsController) List(adAccountId string, optionals *AdAccountsAdGroupsListOptionals) (*[]models.AdGroup, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AdAccountsAdGroupsListOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("ad_groups").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.AdGroup{}
+	request := agc.restClient.Get(path).Operation("AdAccounts.AdGroups.List").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	for _, campaignId := range optionals.CampaignIds {
+		request.URLParam("campaign_ids", campaignId)
+	}
+	for _, adGroupId := range optionals.AdGroupIds {
+		request.URLParam("ad_group_ids", adGroupId)
+	}
+	for _, status := range optionals.EntityStatuses {
+		request.URLParam("entity_statuses", status)
+	}
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.AdGroup), &models.Page{Bookmark: resp.Bookmark}, nil
+}
+
+// Fetch loads an ad group from its id
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/ad_groups/<ad_group_id>
+func (agc *AdAccountsAdGroupsController) Fetch(adAccountId string, adGroupId string) (*models.AdGroup, error) {
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).
+		Segment("ad_groups").Id("ad group", adGroupId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.AdGroup)
+	httpResp, err := agc.restClient.Get(path).Operation("AdAccounts.AdGroups.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.AdGroup), nil
+}
+
+// AdGroupCreateOptionals is a struct that represents the optional parameters
+// that can be passed to the Create endpoint
+type AdGroupCreateOptionals struct {
+	Status                OptionalString
+	BudgetInMicroCurrency OptionalMicroCurrency
+	BidInMicroCurrency    OptionalMicroCurrency
+	BudgetType            OptionalString
+	BillableEvent         OptionalString
+	TargetingSpec         map[string][]string
+	StartTime             *time.Time
+	EndTime               *time.Time
+}
+
+// Create creates a new ad group in a campaign
+// Endpoint: [POST] /v5/ad_accounts/<ad_account_id>/ad_groups
+func (agc *AdAccountsAdGroupsController) Create(adAccountId string, campaignId string, name string, optionals *AdGroupCreateOptionals) (*models.AdGroup, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AdGroupCreateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("ad_groups").Build()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateId("campaign", campaignId); err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{
+		"ad_account_id": adAccountId,
+		"campaign_id":   campaignId,
+		"name":          name,
+	}
+	setIfSet(body, "status", optionals.Status)
+	setMoneyIfSet(body, "budget_in_micro_currency", optionals.BudgetInMicroCurrency)
+	setMoneyIfSet(body, "bid_in_micro_currency", optionals.BidInMicroCurrency)
+	setIfSet(body, "budget_type", optionals.BudgetType)
+	setIfSet(body, "billable_event", optionals.BillableEvent)
+	if optionals.TargetingSpec != nil {
+		body["targeting_spec"] = optionals.TargetingSpec
+	}
+	setTimeIfSet(body, "start_time", optionals.StartTime)
+	setTimeIfSet(body, "end_time", optionals.EndTime)
+
+	// Build + execute request
+	adGroup := new(models.AdGroup)
+	err = executeBulk(agc.restClient.Post(path).Operation("AdAccounts.AdGroups.Create").JSONBody([]interface{}{body}), adGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	// OK
+	return adGroup, nil
+}
+
+// AdGroupUpdateOptionals is a struct that represents the optional parameters
+// that can be passed to the Update endpoint
+type AdGroupUpdateOptionals struct {
+	Name                  OptionalString
+	Status                OptionalString
+	BudgetInMicroCurrency OptionalMicroCurrency
+	BidInMicroCurrency    OptionalMicroCurrency
+	BudgetType            OptionalString
+	BillableEvent         OptionalString
+	TargetingSpec         map[string][]string
+	StartTime             *time.Time
+	EndTime               *time.Time
+}
+
+// Update updates an existing ad group
+// Endpoint: [PATCH] /v5/ad_accounts/<ad_account_id>/ad_groups
+func (agc *AdAccountsAdGroupsController) Update(adAccountId string, adGroupId string, optionals *AdGroupUpdateOptionals) (*models.AdGroup, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AdGroupUpdateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("ad_groups").Build()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateId("ad group", adGroupId); err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{
+		"id":            adGroupId,
+		"ad_account_id": adAccountId,
+	}
+	setIfSet(body, "name", optionals.Name)
+	setIfSet(body, "status", optionals.Status)
+	setMoneyIfSet(body, "budget_in_micro_currency", optionals.BudgetInMicroCurrency)
+	setMoneyIfSet(body, "bid_in_micro_currency", optionals.BidInMicroCurrency)
+	setIfSet(body, "budget_type", optionals.BudgetType)
+	setIfSet(body, "billable_event", optionals.BillableEvent)
+	if optionals.TargetingSpec != nil {
+		body["targeting_spec"] = optionals.TargetingSpec
+	}
+	setTimeIfSet(body, "start_time", optionals.StartTime)
+	setTimeIfSet(body, "end_time", optionals.EndTime)
+
+	// Build + execute request
+	adGroup := new(models.AdGroup)
+	err = executeBulk(agc.restClient.Patch(path).Operation("AdAccounts.AdGroups.Update").JSONBody([]interface{}{body}), adGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	// OK
+	return adGroup, nil
+}
+
+// Archive archives an existing ad group.  Archived ad groups
+// can no longer be edited or activated.
+// Endpoint: [PATCH] /v5/ad_accounts/<ad_account_id>/ad_groups
+func (agc *AdAccountsAdGroupsController) Archive(adAccountId string, adGroupId string) (*models.AdGroup, error) {
+	return agc.Update(adAccountId, adGroupId, &AdGroupUpdateOptionals{
+		Status: String(models.ENTITY_STATUS_ARCHIVED),
+	})
+}
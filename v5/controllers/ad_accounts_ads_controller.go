@@ -0,0 +1,202 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// AdAccountsAdsController is the controller that is responsible for all
+// /v5/ad_accounts/<ad_account_id>/ads endpoints in the Pinterest API.
+type AdAccountsAdsController struct {
+	restClient *rest.Client
+}
+
+// newAdAccountsAdsController instantiates a new AdAccountsAdsController.
+func newAdAccountsAdsController(rc *rest.Client) *AdAccountsAdsController {
+	return &AdAccountsAdsController{
+		restClient: rc,
+	}
+}
+
+// AdAccountsAdsListOptionals is a struct that represents the optional parameters
+// that can be passed to the List endpoint
+type AdAccountsAdsListOptionals struct {
+	Bookmark       string
+	PageSize       int
+	CampaignIds    []string
+	AdGroupIds     []string
+	AdIds          []string
+	EntityStatuses []string
+}
+
+// List loads the ads of an ad account
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/ads
+func (aac *AdAccountsAdsController) List(adAccountId string, optionals *AdAccountsAdsListOptionals) (*[]models.Ad, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AdAccountsAdsListOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("ads").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.Ad{}
+	request := aac.restClient.Get(path).Operation("AdAccounts.Ads.List").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	for _, campaignId := range optionals.CampaignIds {
+		request.URLParam("campaign_ids", campaignId)
+	}
+	for _, adGroupId := range optionals.AdGroupIds {
+		request.URLParam("ad_group_ids", adGroupId)
+	}
+	for _, adId := range optionals.AdIds {
+		request.URLParam("ad_ids", adId)
+	}
+	for _, status := range optionals.EntityStatuses {
+		request.URLParam("entity_statuses", status)
+	}
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.Ad), &models.Page{Bookmark: resp.Bookmark}, nil
+}
+
+// Fetch loads an ad from its id
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/ads/<ad_id>
+func (aac *AdAccountsAdsController) Fetch(adAccountId string, adId string) (*models.Ad, error) {
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).
+		Segment("ads").Id("ad", adId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Ad)
+	httpResp, err := aac.restClient.Get(path).Operation("AdAccounts.Ads.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Ad), nil
+}
+
+// AdCreateOptionals is a struct that represents the optional parameters
+// that can be passed to the Create endpoint
+type AdCreateOptionals struct {
+	Name           OptionalString
+	Status         OptionalString
+	DestinationUrl OptionalString
+}
+
+// Create promotes a pin as a new ad in an ad group
+// Endpoint: [POST] /v5/ad_accounts/<ad_account_id>/ads
+func (aac *AdAccountsAdsController) Create(adAccountId string, adGroupId string, pinId string, creativeType string, optionals *AdCreateOptionals) (*models.Ad, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AdCreateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("ads").Build()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateId("ad group", adGroupId); err != nil {
+		return nil, err
+	}
+	if err := validateId("pin", pinId); err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{
+		"ad_group_id":   adGroupId,
+		"pin_id":        pinId,
+		"creative_type": creativeType,
+	}
+	setIfSet(body, "name", optionals.Name)
+	setIfSet(body, "status", optionals.Status)
+	setIfSet(body, "destination_url", optionals.DestinationUrl)
+
+	// Build + execute request
+	ad := new(models.Ad)
+	err = executeBulk(aac.restClient.Post(path).Operation("AdAccounts.Ads.Create").JSONBody([]interface{}{body}), ad)
+	if err != nil {
+		return nil, err
+	}
+
+	// OK
+	return ad, nil
+}
+
+// AdUpdateOptionals is a struct that represents the optional parameters
+// that can be passed to the Update endpoint
+type AdUpdateOptionals struct {
+	Name           OptionalString
+	Status         OptionalString
+	DestinationUrl OptionalString
+}
+
+// Update updates an existing ad
+// Endpoint: [PATCH] /v5/ad_accounts/<ad_account_id>/ads
+func (aac *AdAccountsAdsController) Update(adAccountId string, adId string, optionals *AdUpdateOptionals) (*models.Ad, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AdUpdateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("ads").Build()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateId("ad", adId); err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{
+		"id": adId,
+	}
+	setIfSet(body, "name", optionals.Name)
+	setIfSet(body, "status", optionals.Status)
+	setIfSet(body, "destination_url", optionals.DestinationUrl)
+
+	// Build + execute request
+	ad := new(models.Ad)
+	err = executeBulk(aac.restClient.Patch(path).Operation("AdAccounts.Ads.Update").JSONBody([]interface{}{body}), ad)
+	if err != nil {
+		return nil, err
+	}
+
+	// OK
+	return ad, nil
+}
+
+// Archive archives an existing ad.  Archived ads
+// can no longer be edited or activated.
+// Endpoint: [PATCH] /v5/ad_accounts/<ad_account_id>/ads
+func (aac *AdAccountsAdsController) Archive(adAccountId string, adId string) (*models.Ad, error) {
+	return aac.Update(adAccountId, adId, &AdUpdateOptionals{
+		Status: String(models.ENTITY_STATUS_ARCHIVED),
+	})
+}
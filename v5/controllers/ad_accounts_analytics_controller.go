@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// AdAccountsAnalyticsController is the controller that is responsible for all
+// /v5/ad_accounts/<ad_account_id>/analytics endpoints in the Pinterest API.
+type AdAccountsAnalyticsController struct {
+	restClient *rest.Client
+}
+
+// newAdAccountsAnalyticsController instantiates a new AdAccountsAnalyticsController.
+func newAdAccountsAnalyticsController(rc *rest.Client) *AdAccountsAnalyticsController {
+	return &AdAccountsAnalyticsController{
+		restClient: rc,
+	}
+}
+
+// AdAccountsAnalyticsFetchOptionals is a struct that represents the optional parameters
+// that can be passed to the Fetch endpoint
+type AdAccountsAnalyticsFetchOptionals struct {
+	// Granularity defaults to DAY.
+	Granularity string
+}
+
+// Fetch loads the metrics of an ad account over a range of dates, with
+// the specified columns (such as SPEND_IN_MICRO_DOLLAR), one row per
+// period of the granularity
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/analytics
+func (aac *AdAccountsAnalyticsController) Fetch(adAccountId string, startDate time.Time, endDate time.Time, columns []string, optionals *AdAccountsAnalyticsFetchOptionals) (*[]models.ReportRow, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AdAccountsAnalyticsFetchOptionals{}
+	}
+	granularity := optionals.Granularity
+	if granularity == "" {
+		granularity = "DAY"
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("analytics").Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate dates
+	start, end, err := dateRange(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.ReportRow{}
+	httpResp, err := aac.restClient.Get(path).Operation("AdAccounts.Analytics.Fetch").
+		URLParam("start_date", start).
+		URLParam("end_date", end).
+		URLParam("columns", strings.Join(columns, ",")).
+		URLParam("granularity", granularity).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.ReportRow), nil
+}
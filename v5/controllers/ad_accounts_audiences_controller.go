@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// AdAccountsAudiencesController is the controller that is responsible for all
+// /v5/ad_accounts/<ad_account_id>/audiences endpoints in the Pinterest API.
+type AdAccountsAudiencesController struct {
+	restClient *rest.Client
+}
+
+// newAdAccountsAudiencesController instantiates a new AdAccountsAudiencesController.
+func newAdAccountsAudiencesController(rc *rest.Client) *AdAccountsAudiencesController {
+	return &AdAccountsAudiencesController{
+		restClient: rc,
+	}
+}
+
+// AdAccountsAudiencesListOptionals is a struct that represents the optional parameters
+// that can be passed to the List endpoint
+type AdAccountsAudiencesListOptionals struct {
+	Bookmark string
+	PageSize int
+}
+
+// List loads the audiences of an ad account
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/audiences
+func (ac *AdAccountsAudiencesController) List(adAccountId string, optionals *AdAccountsAudiencesListOptionals) (*[]models.Audience, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AdAccountsAudiencesListOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("audiences").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.Audience{}
+	request := ac.restClient.Get(path).Operation("AdAccounts.Audiences.List").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.Audience), &models.Page{Bookmark: resp.Bookmark}, nil
+}
+
+// Fetch loads an audience from its id
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/audiences/<audience_id>
+func (ac *AdAccountsAudiencesController) Fetch(adAccountId string, audienceId string) (*models.Audience, error) {
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).
+		Segment("audiences").Id("audience", audienceId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Audience)
+	httpResp, err := ac.restClient.Get(path).Operation("AdAccounts.Audiences.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Audience), nil
+}
+
+// AudienceCreateOptionals is a struct that represents the optional parameters
+// that can be passed to the Create endpoint
+type AudienceCreateOptionals struct {
+	Description OptionalString
+}
+
+// Create creates a new audience of the specified type, such as an
+// models.AUDIENCE_TYPE_CUSTOMER_LIST audience whose rule references
+// a customer list
+// Endpoint: [POST] /v5/ad_accounts/<ad_account_id>/audiences
+func (ac *AdAccountsAudiencesController) Create(adAccountId string, name string, audienceType string, rule models.AudienceRule, optionals *AudienceCreateOptionals) (*models.Audience, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AudienceCreateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("audiences").Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{
+		"name":          name,
+		"audience_type": audienceType,
+		"rule":          rule,
+	}
+	setIfSet(body, "description", optionals.Description)
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Audience)
+	httpResp, err := ac.restClient.Post(path).Operation("AdAccounts.Audiences.Create").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Audience), nil
+}
+
+// AudienceUpdateOptionals is a struct that represents the optional parameters
+// that can be passed to the Update endpoint
+type AudienceUpdateOptionals struct {
+	Name        OptionalString
+	Description OptionalString
+
+	// Rule replaces the audience's rule, if it is not nil.
+	Rule *models.AudienceRule
+}
+
+// Update updates an existing audience
+// Endpoint: [PATCH] /v5/ad_accounts/<ad_account_id>/audiences/<audience_id>
+func (ac *AdAccountsAudiencesController) Update(adAccountId string, audienceId string, optionals *AudienceUpdateOptionals) (*models.Audience, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AudienceUpdateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).
+		Segment("audiences").Id("audience", audienceId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{}
+	setIfSet(body, "name", optionals.Name)
+	setIfSet(body, "description", optionals.Description)
+	if optionals.Rule != nil {
+		body["rule"] = optionals.Rule
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Audience)
+	httpResp, err := ac.restClient.Patch(path).Operation("AdAccounts.Audiences.Update").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Audience), nil
+}
@@ -0,0 +1,205 @@
+package controllers
+
+import (
+	"time"
+
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// AdAccountsCampaignsController is the controller that is responsible for all
+// /v5/ad_accounts/<ad_account_id>/campaigns endpoints in the Pinterest API.
+type AdAccountsCampaignsController struct {
+	restClient *rest.Client
+}
+
+// newAdAccountsCampaignsController instantiates a new AdAccountsCampaignsController.
+func newAdAccountsCampaignsController(rc *rest.Client) *AdAccountsCampaignsController {
+	return &AdAccountsCampaignsController{
+		restClient: rc,
+	}
+}
+
+// AdAccountsCampaignsListOptionals is a struct that represents the optional parameters
+// that can be passed to the List endpoint
+type AdAccountsCampaignsListOptionals struct {
+	Bookmark       string
+	PageSize       int
+	CampaignIds    []string
+	EntityStatuses []string
+}
+
+// List loads the campaigns of an ad account
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/campaigns
+func (acc *AdAccountsCampaignsController) List(adAccountId string, optionals *AdAccountsCampaignsListOptionals) (*[]models.Campaign, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AdAccountsCampaignsListOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("campaigns").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.Campaign{}
+	request := acc.restClient.Get(path).Operation("AdAccounts.Campaigns.List").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	for _, campaignId := range optionals.CampaignIds {
+		request.URLParam("campaign_ids", campaignId)
+	}
+	for _, status := range optionals.EntityStatuses {
+		request.URLParam("entity_statuses", status)
+	}
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.Campaign), &models.Page{Bookmark: resp.Bookmark}, nil
+}
+
+// Fetch loads a campaign from its id
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/campaigns/<campaign_id>
+func (acc *AdAccountsCampaignsController) Fetch(adAccountId string, campaignId string) (*models.Campaign, error) {
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).
+		Segment("campaigns").Id("campaign", campaignId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Campaign)
+	httpResp, err := acc.restClient.Get(path).Operation("AdAccounts.Campaigns.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Campaign), nil
+}
+
+// CampaignCreateOptionals is a struct that represents the optional parameters
+// that can be passed to the Create endpoint
+type CampaignCreateOptionals struct {
+	Status           OptionalString
+	LifetimeSpendCap OptionalMicroCurrency
+	DailySpendCap    OptionalMicroCurrency
+	OrderLineId      OptionalString
+	StartTime        *time.Time
+	EndTime          *time.Time
+}
+
+// Create creates a new campaign in an ad account
+// Endpoint: [POST] /v5/ad_accounts/<ad_account_id>/campaigns
+func (acc *AdAccountsCampaignsController) Create(adAccountId string, name string, objectiveType string, optionals *CampaignCreateOptionals) (*models.Campaign, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &CampaignCreateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("campaigns").Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{
+		"ad_account_id":  adAccountId,
+		"name":           name,
+		"objective_type": objectiveType,
+	}
+	setIfSet(body, "status", optionals.Status)
+	setMoneyIfSet(body, "lifetime_spend_cap", optionals.LifetimeSpendCap)
+	setMoneyIfSet(body, "daily_spend_cap", optionals.DailySpendCap)
+	setIfSet(body, "order_line_id", optionals.OrderLineId)
+	setTimeIfSet(body, "start_time", optionals.StartTime)
+	setTimeIfSet(body, "end_time", optionals.EndTime)
+
+	// Build + execute request
+	campaign := new(models.Campaign)
+	err = executeBulk(acc.restClient.Post(path).Operation("AdAccounts.Campaigns.Create").JSONBody([]interface{}{body}), campaign)
+	if err != nil {
+		return nil, err
+	}
+
+	// OK
+	return campaign, nil
+}
+
+// CampaignUpdateOptionals is a struct that represents the optional parameters
+// that can be passed to the Update endpoint
+type CampaignUpdateOptionals struct {
+	Name             OptionalString
+	Status           OptionalString
+	LifetimeSpendCap OptionalMicroCurrency
+	DailySpendCap    OptionalMicroCurrency
+	OrderLineId      OptionalString
+	StartTime        *time.Time
+	EndTime          *time.Time
+}
+
+// Update updates an existing campaign
+// Endpoint: [PATCH] /v5/ad_accounts/<ad_account_id>/campaigns
+func (acc *AdAccountsCampaignsController) Update(adAccountId string, campaignId string, optionals *CampaignUpdateOptionals) (*models.Campaign, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &CampaignUpdateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("campaigns").Build()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateId("campaign", campaignId); err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{
+		"id":            campaignId,
+		"ad_account_id": adAccountId,
+	}
+	setIfSet(body, "name", optionals.Name)
+	setIfSet(body, "status", optionals.Status)
+	setMoneyIfSet(body, "lifetime_spend_cap", optionals.LifetimeSpendCap)
+	setMoneyIfSet(body, "daily_spend_cap", optionals.DailySpendCap)
+	setIfSet(body, "order_line_id", optionals.OrderLineId)
+	setTimeIfSet(body, "start_time", optionals.StartTime)
+	setTimeIfSet(body, "end_time", optionals.EndTime)
+
+	// Build + execute request
+	campaign := new(models.Campaign)
+	err = executeBulk(acc.restClient.Patch(path).Operation("AdAccounts.Campaigns.Update").JSONBody([]interface{}{body}), campaign)
+	if err != nil {
+		return nil, err
+	}
+
+	// OK
+	return campaign, nil
+}
+
+// Archive archives an existing campaign.  Archived campaigns
+// can no longer be edited or activated.
+// Endpoint: [PATCH] /v5/ad_accounts/<ad_account_id>/campaigns
+func (acc *AdAccountsCampaignsController) Archive(adAccountId string, campaignId string) (*models.Campaign, error) {
+	return acc.Update(adAccountId, campaignId, &CampaignUpdateOptionals{
+		Status: String(models.ENTITY_STATUS_ARCHIVED),
+	})
+}
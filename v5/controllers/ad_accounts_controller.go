@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// AdAccountsController is the controller that is responsible for all
+// /v5/ad_accounts endpoints in the Pinterest API.
+type AdAccountsController struct {
+	restClient     *rest.Client
+	Campaigns      *AdAccountsCampaignsController
+	AdGroups       *AdAccountsAdGroupsController
+	Ads            *AdAccountsAdsController
+	Analytics      *AdAccountsAnalyticsController
+	Reports        *AdAccountsReportsController
+	CustomerLists  *AdAccountsCustomerListsController
+	Audiences      *AdAccountsAudiencesController
+	Events         *AdAccountsEventsController
+	KeywordMetrics *AdAccountsKeywordMetricsController
+}
+
+// NewAdAccountsController instantiates a new AdAccountsController.
+//
+// Reports are downloaded with downloadClient, which must not authorize
+// requests, as they are sent to the storage host rather than Pinterest.
+func NewAdAccountsController(rc *rest.Client, downloadClient *rest.Client) *AdAccountsController {
+	return &AdAccountsController{
+		restClient:     rc,
+		Campaigns:      newAdAccountsCampaignsController(rc),
+		AdGroups:       newAdAccountsAdGroupsController(rc),
+		Ads:            newAdAccountsAdsController(rc),
+		Analytics:      newAdAccountsAnalyticsController(rc),
+		Reports:        newAdAccountsReportsController(rc, downloadClient),
+		CustomerLists:  newAdAccountsCustomerListsController(rc),
+		Audiences:      newAdAccountsAudiencesController(rc),
+		Events:         newAdAccountsEventsController(rc),
+		KeywordMetrics: newAdAccountsKeywordMetricsController(rc),
+	}
+}
+
+// AdAccountsListOptionals is a struct that represents the optional parameters
+// that can be passed to the List endpoint
+type AdAccountsListOptionals struct {
+	Bookmark string
+	PageSize int
+}
+
+// List loads the ad accounts the authorized user has access to
+// Endpoint: [GET] /v5/ad_accounts
+func (aac *AdAccountsController) List(optionals *AdAccountsListOptionals) (*[]models.AdAccount, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AdAccountsListOptionals{}
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.AdAccount{}
+	request := aac.restClient.Get("/ad_accounts").Operation("AdAccounts.List").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.AdAccount), &models.Page{Bookmark: resp.Bookmark}, nil
+}
+
+// Fetch loads an ad account from its id
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>
+func (aac *AdAccountsController) Fetch(adAccountId string) (*models.AdAccount, error) {
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.AdAccount)
+	httpResp, err := aac.restClient.Get(path).Operation("AdAccounts.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.AdAccount), nil
+}
@@ -0,0 +1,234 @@
+package controllers
+
+import (
+	"strings"
+
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// AdAccountsCustomerListsController is the controller that is responsible for all
+// /v5/ad_accounts/<ad_account_id>/customer_lists endpoints in the Pinterest API.
+type AdAccountsCustomerListsController struct {
+	restClient *rest.Client
+}
+
+// newAdAccountsCustomerListsController instantiates a new AdAccountsCustomerListsController.
+func newAdAccountsCustomerListsController(rc *rest.Client) *AdAccountsCustomerListsController {
+	return &AdAccountsCustomerListsController{
+		restClient: rc,
+	}
+}
+
+// AdAccountsCustomerListsListOptionals is a struct that represents the optional parameters
+// that can be passed to the List endpoint
+type AdAccountsCustomerListsListOptionals struct {
+	Bookmark string
+	PageSize int
+}
+
+// List loads the customer lists of an ad account
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/customer_lists
+func (clc *AdAccountsCustomerListsController) List(adAccountId string, optionals *AdAccountsCustomerListsListOptionals) (*[]models.CustomerList, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AdAccountsCustomerListsListOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("customer_lists").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.CustomerList{}
+	request := clc.restClient.Get(path).Operation("AdAccounts.CustomerLists.List").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.CustomerList), &models.Page{Bookmark: resp.Bookmark}, nil
+}
+
+// Fetch loads a customer list from its id
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/customer_lists/<customer_list_id>
+func (clc *AdAccountsCustomerListsController) Fetch(adAccountId string, customerListId string) (*models.CustomerList, error) {
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).
+		Segment("customer_lists").Id("customer list", customerListId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.CustomerList)
+	httpResp, err := clc.restClient.Get(path).Operation("AdAccounts.CustomerLists.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.CustomerList), nil
+}
+
+// CustomerListRecordsOptionals is a struct that represents the optional parameters
+// that can be passed to the Create, Append and Remove endpoints
+type CustomerListRecordsOptionals struct {
+	// ChunkSize is how many records are sent per request.
+	// Defaults to models.MAX_CUSTOMER_LIST_RECORDS_PER_REQUEST.
+	ChunkSize int
+}
+
+// Create creates a new customer list of the specified type (such as
+// models.CUSTOMER_LIST_TYPE_EMAIL) holding records.
+//
+// Records are normalized and hashed with SHA-256 before they are sent,
+// unless they are already hashed.  Large lists are uploaded in chunks:
+// the list is created with the first, and the rest are appended to it.
+// If a chunk fails, the list is returned along with a
+// *models.PartialUploadError.  If there are no records,
+// models.ErrNoCustomerListRecords is returned and nothing is sent.
+// Endpoint: [POST] /v5/ad_accounts/<ad_account_id>/customer_lists
+func (clc *AdAccountsCustomerListsController) Create(adAccountId string, name string, listType string, records []string, optionals *CustomerListRecordsOptionals) (*models.CustomerList, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &CustomerListRecordsOptionals{}
+	}
+
+	if len(records) == 0 {
+		return nil, models.ErrNoCustomerListRecords
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("customer_lists").Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request, with the first chunk
+	chunks := chunkRecords(listType, records, optionals.ChunkSize)
+	resp := new(models.Response)
+	resp.Data = new(models.CustomerList)
+	httpResp, err := clc.restClient.Post(path).Operation("AdAccounts.CustomerLists.Create").
+		JSONBody(map[string]interface{}{
+			"name":      name,
+			"list_type": listType,
+			"records":   strings.Join(chunks[0], ","),
+		}).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// Append the rest
+	customerList := resp.Data.(*models.CustomerList)
+	updated, err := clc.update(adAccountId, customerList.Id, "ADD", chunks[1:], len(chunks[0]), len(records))
+	if updated != nil {
+		customerList = updated
+	}
+	return customerList, err
+}
+
+// Append adds records to an existing customer list.  Records are
+// normalized, hashed and chunked the same way as in Create, and
+// models.ErrNoCustomerListRecords is returned if there are none.
+// Endpoint: [PATCH] /v5/ad_accounts/<ad_account_id>/customer_lists/<customer_list_id>
+func (clc *AdAccountsCustomerListsController) Append(adAccountId string, customerListId string, listType string, records []string, optionals *CustomerListRecordsOptionals) (*models.CustomerList, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &CustomerListRecordsOptionals{}
+	}
+	if len(records) == 0 {
+		return nil, models.ErrNoCustomerListRecords
+	}
+	return clc.update(adAccountId, customerListId, "ADD", chunkRecords(listType, records, optionals.ChunkSize), 0, len(records))
+}
+
+// Remove removes records from an existing customer list.  Records are
+// normalized, hashed and chunked the same way as in Create, and
+// models.ErrNoCustomerListRecords is returned if there are none.
+// Endpoint: [PATCH] /v5/ad_accounts/<ad_account_id>/customer_lists/<customer_list_id>
+func (clc *AdAccountsCustomerListsController) Remove(adAccountId string, customerListId string, listType string, records []string, optionals *CustomerListRecordsOptionals) (*models.CustomerList, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &CustomerListRecordsOptionals{}
+	}
+	if len(records) == 0 {
+		return nil, models.ErrNoCustomerListRecords
+	}
+	return clc.update(adAccountId, customerListId, "REMOVE", chunkRecords(listType, records, optionals.ChunkSize), 0, len(records))
+}
+
+// update sends each chunk of records with the operation, and returns the
+// customer list as of the last chunk.  uploaded is how many of the total
+// records were already uploaded before these chunks.
+func (clc *AdAccountsCustomerListsController) update(adAccountId string, customerListId string, operation string, chunks [][]string, uploaded int, total int) (*models.CustomerList, error) {
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).
+		Segment("customer_lists").Id("customer list", customerListId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	var customerList *models.CustomerList
+	for _, chunk := range chunks {
+		// Build + execute request
+		resp := new(models.Response)
+		resp.Data = new(models.CustomerList)
+		httpResp, err := clc.restClient.Patch(path).Operation("AdAccounts.CustomerLists.Update").
+			JSONBody(map[string]interface{}{
+				"operation_type": operation,
+				"records":        strings.Join(chunk, ","),
+			}).
+			Into(resp).
+			Execute()
+
+		// Check Error
+		if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+			return customerList, &models.PartialUploadError{Uploaded: uploaded, Total: total, Err: err}
+		}
+		customerList = resp.Data.(*models.CustomerList)
+		uploaded += len(chunk)
+	}
+
+	// OK
+	return customerList, nil
+}
+
+// chunkRecords normalizes and hashes records, and splits them into chunks
+// of chunkSize.
+func chunkRecords(listType string, records []string, chunkSize int) [][]string {
+	if chunkSize <= 0 {
+		chunkSize = models.MAX_CUSTOMER_LIST_RECORDS_PER_REQUEST
+	}
+	chunks := [][]string{}
+	for start := 0; start < len(records); start += chunkSize {
+		end := start + chunkSize
+		if end > len(records) {
+			end = len(records)
+		}
+		chunk := make([]string, 0, end-start)
+		for _, record := range records[start:end] {
+			chunk = append(chunk, models.NormalizeCustomerListRecord(listType, record))
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
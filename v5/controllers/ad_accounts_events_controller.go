@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// AdAccountsEventsController is the controller that is responsible for all
+// /v5/ad_accounts/<ad_account_id>/events endpoints in the Pinterest API.
+type AdAccountsEventsController struct {
+	restClient *rest.Client
+}
+
+// newAdAccountsEventsController instantiates a new AdAccountsEventsController.
+func newAdAccountsEventsController(rc *rest.Client) *AdAccountsEventsController {
+	return &AdAccountsEventsController{
+		restClient: rc,
+	}
+}
+
+// AdAccountsEventsCreateOptionals is a struct that represents the optional parameters
+// that can be passed to the Create endpoint
+type AdAccountsEventsCreateOptionals struct {
+	// Test sends the events as test events, which are
+	// validated by Pinterest but not recorded.
+	Test bool
+}
+
+// Create sends up to models.MAX_CONVERSION_EVENTS_PER_REQUEST conversion
+// events to the Conversions API.
+//
+// Every event is validated before anything is sent, and its user data is
+// normalized and hashed.  Pinterest processes each event separately: the
+// returned result holds the status of each one, in the order they were sent.
+// Endpoint: [POST] /v5/ad_accounts/<ad_account_id>/events
+func (ec *AdAccountsEventsController) Create(adAccountId string, events []models.ConversionEvent, optionals *AdAccountsEventsCreateOptionals) (*models.ConversionEventsResult, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &AdAccountsEventsCreateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("events").Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build body
+	if len(events) == 0 || len(events) > models.MAX_CONVERSION_EVENTS_PER_REQUEST {
+		return nil, fmt.Errorf("between 1 and %d conversion events can be sent at once, not %d",
+			models.MAX_CONVERSION_EVENTS_PER_REQUEST, len(events))
+	}
+	data := make([]models.ConversionEvent, len(events))
+	for i, event := range events {
+		if err := event.Validate(); err != nil {
+			return nil, err
+		}
+		event.UserData = event.UserData.Hashed()
+		data[i] = event
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.ConversionEventsResult)
+	request := ec.restClient.Post(path).Operation("AdAccounts.Events.Create").
+		JSONBody(map[string]interface{}{"data": data}).
+		Into(resp)
+	if optionals.Test {
+		request.URLParam("test", "true")
+	}
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.ConversionEventsResult), nil
+}
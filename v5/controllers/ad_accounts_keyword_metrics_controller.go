@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// AdAccountsKeywordMetricsController is the controller that is responsible for all
+// /v5/ad_accounts/<ad_account_id>/keywords/metrics endpoints in the Pinterest API.
+type AdAccountsKeywordMetricsController struct {
+	restClient *rest.Client
+}
+
+// newAdAccountsKeywordMetricsController instantiates a new AdAccountsKeywordMetricsController.
+func newAdAccountsKeywordMetricsController(rc *rest.Client) *AdAccountsKeywordMetricsController {
+	return &AdAccountsKeywordMetricsController{
+		restClient: rc,
+	}
+}
+
+// Fetch loads the search metrics of keywords in a
+// country, identified by its code (such as US)
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/keywords/metrics
+func (kmc *AdAccountsKeywordMetricsController) Fetch(adAccountId string, countryCode string, keywords []string) (*[]models.KeywordMetrics, error) {
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).
+		Segment("keywords", "metrics").Build()
+	if err != nil {
+		return nil, err
+	}
+	if len(keywords) == 0 {
+		return nil, fmt.Errorf("at least one keyword is required")
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.KeywordMetricsList)
+	httpResp, err := kmc.restClient.Get(path).Operation("AdAccounts.KeywordMetrics.Fetch").
+		URLParam("country_code", countryCode).
+		URLParam("keywords", strings.Join(keywords, ",")).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return &resp.Data.(*models.KeywordMetricsList).All, nil
+}
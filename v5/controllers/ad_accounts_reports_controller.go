@@ -0,0 +1,159 @@
+package controllers
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// AdAccountsReportsController is the controller that is responsible for all
+// /v5/ad_accounts/<ad_account_id>/reports endpoints in the Pinterest API.
+type AdAccountsReportsController struct {
+	restClient     *rest.Client
+	downloadClient *rest.Client
+}
+
+// newAdAccountsReportsController instantiates a new AdAccountsReportsController.
+func newAdAccountsReportsController(rc *rest.Client, downloadClient *rest.Client) *AdAccountsReportsController {
+	return &AdAccountsReportsController{
+		restClient:     rc,
+		downloadClient: downloadClient,
+	}
+}
+
+// ReportCreateOptionals is a struct that represents the optional parameters
+// that can be passed to the Create endpoint
+type ReportCreateOptionals struct {
+	// Granularity defaults to DAY.
+	Granularity string
+
+	// Level defaults to ADVERTISER (the whole ad account).
+	Level string
+
+	// ReportFormat defaults to models.REPORT_FORMAT_JSON.
+	ReportFormat string
+}
+
+// Create requests an asynchronous report of an ad account's metrics over
+// a range of dates, with the specified columns.  The returned Report's
+// Token can be used to Fetch it, until it has been generated.
+// Endpoint: [POST] /v5/ad_accounts/<ad_account_id>/reports
+func (arc *AdAccountsReportsController) Create(adAccountId string, startDate time.Time, endDate time.Time, columns []string, optionals *ReportCreateOptionals) (*models.Report, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &ReportCreateOptionals{}
+	}
+	body := map[string]interface{}{
+		"granularity":   "DAY",
+		"level":         "ADVERTISER",
+		"report_format": models.REPORT_FORMAT_JSON,
+		"columns":       columns,
+	}
+	if optionals.Granularity != "" {
+		body["granularity"] = optionals.Granularity
+	}
+	if optionals.Level != "" {
+		body["level"] = optionals.Level
+	}
+	if optionals.ReportFormat != "" {
+		body["report_format"] = optionals.ReportFormat
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("reports").Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate dates
+	start, end, err := dateRange(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	body["start_date"] = start
+	body["end_date"] = end
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Report)
+	httpResp, err := arc.restClient.Post(path).Operation("AdAccounts.Reports.Create").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Report), nil
+}
+
+// Fetch loads the status of a report, and where it can be downloaded
+// from once it has been generated
+// Endpoint: [GET] /v5/ad_accounts/<ad_account_id>/reports
+func (arc *AdAccountsReportsController) Fetch(adAccountId string, token string) (*models.Report, error) {
+	// Build path
+	path, err := endpoint.NewV5("ad_accounts").Id("ad account", adAccountId).Segment("reports").Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Report)
+	httpResp, err := arc.restClient.Get(path).Operation("AdAccounts.Reports.Fetch").
+		URLParam("token", token).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	report := resp.Data.(*models.Report)
+	if report.Token == "" {
+		report.Token = token
+	}
+	return report, nil
+}
+
+// Download downloads a report that has FINISHED, and parses it in the
+// format it was requested in (models.REPORT_FORMAT_CSV or
+// models.REPORT_FORMAT_JSON).
+func (arc *AdAccountsReportsController) Download(report *models.Report, format string) (*[]models.ReportRow, error) {
+	// Build + execute request
+	req, err := http.NewRequest("GET", report.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := arc.downloadClient.Do(req, "AdAccounts.Reports.Download")
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	// Check Error
+	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
+		message, _ := ioutil.ReadAll(io.LimitReader(httpResp.Body, 1024))
+		return nil, &models.PinterestError{
+			StatusCode: httpResp.StatusCode,
+			Message:    "report download failed: " + string(message),
+		}
+	}
+
+	// OK
+	rows, err := models.ParseReport(format, httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &rows, nil
+}
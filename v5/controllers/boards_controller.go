@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// BoardsController is the controller that is responsible for all
+// /v5/boards endpoints in the Pinterest API.
+type BoardsController struct {
+	restClient *rest.Client
+	Pins       *BoardsPinsController
+	Sections   *BoardsSectionsController
+}
+
+// NewBoardsController instantiates a new BoardsController.
+func NewBoardsController(rc *rest.Client) *BoardsController {
+	return &BoardsController{
+		restClient: rc,
+		Pins:       newBoardsPinsController(rc),
+		Sections:   newBoardsSectionsController(rc),
+	}
+}
+
+// BoardsListOptionals is a struct that represents the optional parameters
+// that can be passed to the List endpoint
+type BoardsListOptionals struct {
+	Bookmark string
+	PageSize int
+	Privacy  string
+}
+
+// List loads the authorized user's boards
+// Endpoint: [GET] /v5/boards
+func (bc *BoardsController) List(optionals *BoardsListOptionals) (*[]models.Board, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardsListOptionals{}
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.Board{}
+	request := bc.restClient.Get("/boards").Operation("Boards.List").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	if optionals.Privacy != "" {
+		request.URLParam("privacy", optionals.Privacy)
+	}
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.Board), &models.Page{Bookmark: resp.Bookmark}, nil
+}
+
+// Fetch loads a board from its id
+// Endpoint: [GET] /v5/boards/<board_id>
+func (bc *BoardsController) Fetch(boardId string) (*models.Board, error) {
+	// Build path
+	path, err := endpoint.NewV5("boards").Id("board", boardId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Board)
+	httpResp, err := bc.restClient.Get(path).Operation("Boards.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Board), nil
+}
+
+// BoardCreateOptionals is a struct that represents the optional parameters
+// that can be passed to the Create endpoint
+type BoardCreateOptionals struct {
+	Description OptionalString
+	Privacy     OptionalString
+}
+
+// Create makes a new board
+// Endpoint: [POST] /v5/boards
+func (bc *BoardsController) Create(boardName string, optionals *BoardCreateOptionals) (*models.Board, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardCreateOptionals{}
+	}
+
+	// Build body
+	body := map[string]interface{}{
+		"name": boardName,
+	}
+	setIfSet(body, "description", optionals.Description)
+	setIfSet(body, "privacy", optionals.Privacy)
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Board)
+	httpResp, err := bc.restClient.Post("/boards").Operation("Boards.Create").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Board), nil
+}
+
+// BoardUpdateOptionals is a struct that represents the optional parameters
+// that can be passed to the Update endpoint
+type BoardUpdateOptionals struct {
+	Name        OptionalString
+	Description OptionalString
+	Privacy     OptionalString
+}
+
+// Update updates an existing board
+// Endpoint: [PATCH] /v5/boards/<board_id>
+func (bc *BoardsController) Update(boardId string, optionals *BoardUpdateOptionals) (*models.Board, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardUpdateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("boards").Id("board", boardId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{}
+	setIfSet(body, "name", optionals.Name)
+	setIfSet(body, "description", optionals.Description)
+	setIfSet(body, "privacy", optionals.Privacy)
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Board)
+	httpResp, err := bc.restClient.Patch(path).Operation("Boards.Update").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Board), nil
+}
+
+// Delete deletes an existing board
+// Endpoint: [DELETE] /v5/boards/<board_id>
+func (bc *BoardsController) Delete(boardId string) error {
+	// Build path
+	path, err := endpoint.NewV5("boards").Id("board", boardId).Build()
+	if err != nil {
+		return err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	httpResp, err := bc.restClient.Delete(path).Operation("Boards.Delete").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return err
+	}
+
+	// OK
+	return nil
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// BoardsPinsController is the controller that is responsible for all
+// /v5/boards/<board_id>/pins endpoints in the Pinterest API.
+type BoardsPinsController struct {
+	restClient *rest.Client
+}
+
+// newBoardsPinsController instantiates a new BoardsPinsController.
+func newBoardsPinsController(rc *rest.Client) *BoardsPinsController {
+	return &BoardsPinsController{
+		restClient: rc,
+	}
+}
+
+// BoardsPinsFetchOptionals is a struct that represents the optional parameters
+// that can be passed to the Fetch endpoint
+type BoardsPinsFetchOptionals struct {
+	Bookmark string
+	PageSize int
+}
+
+// Fetch loads the pins on a board
+// Endpoint: [GET] /v5/boards/<board_id>/pins
+func (bpc *BoardsPinsController) Fetch(boardId string, optionals *BoardsPinsFetchOptionals) (*[]models.Pin, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardsPinsFetchOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("boards").Id("board", boardId).Segment("pins").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.Pin{}
+	request := bpc.restClient.Get(path).Operation("Boards.Pins.Fetch").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.Pin), &models.Page{Bookmark: resp.Bookmark}, nil
+}
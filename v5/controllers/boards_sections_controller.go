@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// BoardsSectionsController is the controller that is responsible for all
+// /v5/boards/<board_id>/sections endpoints in the Pinterest API.
+type BoardsSectionsController struct {
+	restClient *rest.Client
+	Pins       *BoardsSectionsPinsController
+}
+
+// newBoardsSectionsController instantiates a new BoardsSectionsController.
+func newBoardsSectionsController(rc *rest.Client) *BoardsSectionsController {
+	return &BoardsSectionsController{
+		restClient: rc,
+		Pins:       newBoardsSectionsPinsController(rc),
+	}
+}
+
+// BoardsSectionsFetchOptionals is a struct that represents the optional parameters
+// that can be passed to the Fetch endpoint
+type BoardsSectionsFetchOptionals struct {
+	Bookmark string
+	PageSize int
+}
+
+// Fetch loads the sections of a board
+// Endpoint: [GET] /v5/boards/<board_id>/sections
+func (bsc *BoardsSectionsController) Fetch(boardId string, optionals *BoardsSectionsFetchOptionals) (*[]models.BoardSection, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardsSectionsFetchOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("boards").Id("board", boardId).Segment("sections").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.BoardSection{}
+	request := bsc.restClient.Get(path).Operation("Boards.Sections.Fetch").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.BoardSection), &models.Page{Bookmark: resp.Bookmark}, nil
+}
+
+// Create makes a new section on a board
+// Endpoint: [POST] /v5/boards/<board_id>/sections
+func (bsc *BoardsSectionsController) Create(boardId string, name string) (*models.BoardSection, error) {
+	// Build path
+	path, err := endpoint.NewV5("boards").Id("board", boardId).Segment("sections").Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.BoardSection)
+	httpResp, err := bsc.restClient.Post(path).Operation("Boards.Sections.Create").
+		JSONBody(map[string]interface{}{"name": name}).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.BoardSection), nil
+}
+
+// BoardsSectionsUpdateOptionals is a struct that represents the optional parameters
+// that can be passed to the Update endpoint
+type BoardsSectionsUpdateOptionals struct {
+	Name OptionalString
+}
+
+// Update updates (renames) an existing section of a board
+// Endpoint: [PATCH] /v5/boards/<board_id>/sections/<section_id>
+func (bsc *BoardsSectionsController) Update(boardId string, sectionId string, optionals *BoardsSectionsUpdateOptionals) (*models.BoardSection, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardsSectionsUpdateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("boards").Id("board", boardId).Segment("sections").Id("section", sectionId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{}
+	setIfSet(body, "name", optionals.Name)
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.BoardSection)
+	httpResp, err := bsc.restClient.Patch(path).Operation("Boards.Sections.Update").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.BoardSection), nil
+}
+
+// Delete deletes an existing section of a board
+// Endpoint: [DELETE] /v5/boards/<board_id>/sections/<section_id>
+func (bsc *BoardsSectionsController) Delete(boardId string, sectionId string) error {
+	// Build path
+	path, err := endpoint.NewV5("boards").Id("board", boardId).Segment("sections").Id("section", sectionId).Build()
+	if err != nil {
+		return err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	httpResp, err := bsc.restClient.Delete(path).Operation("Boards.Sections.Delete").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return err
+	}
+
+	// OK
+	return nil
+}
@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// BoardsSectionsPinsController is the controller that is responsible for all
+// /v5/boards/<board_id>/sections/<section_id>/pins endpoints
+// in the Pinterest API.
+type BoardsSectionsPinsController struct {
+	restClient *rest.Client
+}
+
+// newBoardsSectionsPinsController instantiates a new BoardsSectionsPinsController.
+func newBoardsSectionsPinsController(rc *rest.Client) *BoardsSectionsPinsController {
+	return &BoardsSectionsPinsController{
+		restClient: rc,
+	}
+}
+
+// BoardsSectionsPinsFetchOptionals is a struct that represents the optional parameters
+// that can be passed to the Fetch endpoint
+type BoardsSectionsPinsFetchOptionals struct {
+	Bookmark string
+	PageSize int
+}
+
+// Fetch loads the pins in a section of a board
+// Endpoint: [GET] /v5/boards/<board_id>/sections/<section_id>/pins
+func (bspc *BoardsSectionsPinsController) Fetch(boardId string, sectionId string, optionals *BoardsSectionsPinsFetchOptionals) (*[]models.Pin, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &BoardsSectionsPinsFetchOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("boards").Id("board", boardId).Segment("sections").Id("section", sectionId).Segment("pins").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.Pin{}
+	request := bspc.restClient.Get(path).Operation("Boards.Sections.Pins.Fetch").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.Pin), &models.Page{Bookmark: resp.Bookmark}, nil
+}
@@ -0,0 +1,24 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/rest"
+)
+
+// CatalogsController is the controller that is responsible for all
+// /v5/catalogs endpoints in the Pinterest API.
+type CatalogsController struct {
+	restClient    *rest.Client
+	Feeds         *CatalogsFeedsController
+	ProductGroups *CatalogsProductGroupsController
+	Items         *CatalogsItemsController
+}
+
+// NewCatalogsController instantiates a new CatalogsController.
+func NewCatalogsController(rc *rest.Client) *CatalogsController {
+	return &CatalogsController{
+		restClient:    rc,
+		Feeds:         newCatalogsFeedsController(rc),
+		ProductGroups: newCatalogsProductGroupsController(rc),
+		Items:         newCatalogsItemsController(rc),
+	}
+}
@@ -0,0 +1,227 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// CatalogsFeedsController is the controller that is responsible for all
+// /v5/catalogs/feeds endpoints in the Pinterest API.
+type CatalogsFeedsController struct {
+	restClient        *rest.Client
+	ProcessingResults *CatalogsFeedsProcessingResultsController
+}
+
+// newCatalogsFeedsController instantiates a new CatalogsFeedsController.
+func newCatalogsFeedsController(rc *rest.Client) *CatalogsFeedsController {
+	return &CatalogsFeedsController{
+		restClient:        rc,
+		ProcessingResults: newCatalogsFeedsProcessingResultsController(rc),
+	}
+}
+
+// CatalogsFeedsListOptionals is a struct that represents the optional parameters
+// that can be passed to the List endpoint
+type CatalogsFeedsListOptionals struct {
+	Bookmark string
+	PageSize int
+}
+
+// List loads the authorized user's product feeds
+// Endpoint: [GET] /v5/catalogs/feeds
+func (cfc *CatalogsFeedsController) List(optionals *CatalogsFeedsListOptionals) (*[]models.CatalogFeed, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &CatalogsFeedsListOptionals{}
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.CatalogFeed{}
+	request := cfc.restClient.Get("/catalogs/feeds").Operation("Catalogs.Feeds.List").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.CatalogFeed), &models.Page{Bookmark: resp.Bookmark}, nil
+}
+
+// Fetch loads a product feed from its id
+// Endpoint: [GET] /v5/catalogs/feeds/<feed_id>
+func (cfc *CatalogsFeedsController) Fetch(feedId string) (*models.CatalogFeed, error) {
+	// Build path
+	path, err := endpoint.NewV5("catalogs", "feeds").Id("feed", feedId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.CatalogFeed)
+	httpResp, err := cfc.restClient.Get(path).Operation("Catalogs.Feeds.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.CatalogFeed), nil
+}
+
+// CatalogFeedCreateOptionals is a struct that represents the optional parameters
+// that can be passed to the Create endpoint
+type CatalogFeedCreateOptionals struct {
+	DefaultCurrency     OptionalString
+	DefaultAvailability OptionalString
+	Status              OptionalString
+}
+
+// Create creates a new product feed, which Pinterest fetches from
+// location in the specified format (CSV, TSV or XML)
+// Endpoint: [POST] /v5/catalogs/feeds
+func (cfc *CatalogsFeedsController) Create(name string, format string, location string, defaultLocale string, optionals *CatalogFeedCreateOptionals) (*models.CatalogFeed, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &CatalogFeedCreateOptionals{}
+	}
+
+	// Build body
+	body := map[string]interface{}{
+		"name":           name,
+		"format":         format,
+		"location":       location,
+		"default_locale": defaultLocale,
+	}
+	setIfSet(body, "default_currency", optionals.DefaultCurrency)
+	setIfSet(body, "default_availability", optionals.DefaultAvailability)
+	setIfSet(body, "status", optionals.Status)
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.CatalogFeed)
+	httpResp, err := cfc.restClient.Post("/catalogs/feeds").Operation("Catalogs.Feeds.Create").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.CatalogFeed), nil
+}
+
+// CatalogFeedUpdateOptionals is a struct that represents the optional parameters
+// that can be passed to the Update endpoint
+type CatalogFeedUpdateOptionals struct {
+	Name                OptionalString
+	Format              OptionalString
+	Location            OptionalString
+	DefaultLocale       OptionalString
+	DefaultCurrency     OptionalString
+	DefaultAvailability OptionalString
+	Status              OptionalString
+}
+
+// Update updates an existing product feed
+// Endpoint: [PATCH] /v5/catalogs/feeds/<feed_id>
+func (cfc *CatalogsFeedsController) Update(feedId string, optionals *CatalogFeedUpdateOptionals) (*models.CatalogFeed, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &CatalogFeedUpdateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("catalogs", "feeds").Id("feed", feedId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{}
+	setIfSet(body, "name", optionals.Name)
+	setIfSet(body, "format", optionals.Format)
+	setIfSet(body, "location", optionals.Location)
+	setIfSet(body, "default_locale", optionals.DefaultLocale)
+	setIfSet(body, "default_currency", optionals.DefaultCurrency)
+	setIfSet(body, "default_availability", optionals.DefaultAvailability)
+	setIfSet(body, "status", optionals.Status)
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.CatalogFeed)
+	httpResp, err := cfc.restClient.Patch(path).Operation("Catalogs.Feeds.Update").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.CatalogFeed), nil
+}
+
+// Delete deletes an existing product feed
+// Endpoint: [DELETE] /v5/catalogs/feeds/<feed_id>
+func (cfc *CatalogsFeedsController) Delete(feedId string) error {
+	// Build path
+	path, err := endpoint.NewV5("catalogs", "feeds").Id("feed", feedId).Build()
+	if err != nil {
+		return err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	httpResp, err := cfc.restClient.Delete(path).Operation("Catalogs.Feeds.Delete").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return err
+	}
+
+	// OK
+	return nil
+}
+
+// Ingest triggers Pinterest to fetch and process a product feed now,
+// rather than on its usual schedule.  The outcome can be inspected
+// with ProcessingResults.
+// Endpoint: [POST] /v5/catalogs/feeds/<feed_id>/ingest
+func (cfc *CatalogsFeedsController) Ingest(feedId string) error {
+	// Build path
+	path, err := endpoint.NewV5("catalogs", "feeds").Id("feed", feedId).Segment("ingest").Build()
+	if err != nil {
+		return err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	httpResp, err := cfc.restClient.Post(path).Operation("Catalogs.Feeds.Ingest").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return err
+	}
+
+	// OK
+	return nil
+}
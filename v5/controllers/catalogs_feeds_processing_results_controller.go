@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// CatalogsFeedsProcessingResultsController is the controller that is responsible for all
+// /v5/catalogs/feeds/<feed_id>/processing_results endpoints in the Pinterest API.
+type CatalogsFeedsProcessingResultsController struct {
+	restClient *rest.Client
+}
+
+// newCatalogsFeedsProcessingResultsController instantiates a new CatalogsFeedsProcessingResultsController.
+func newCatalogsFeedsProcessingResultsController(rc *rest.Client) *CatalogsFeedsProcessingResultsController {
+	return &CatalogsFeedsProcessingResultsController{
+		restClient: rc,
+	}
+}
+
+// CatalogsFeedsProcessingResultsFetchOptionals is a struct that represents the optional parameters
+// that can be passed to the Fetch endpoint
+type CatalogsFeedsProcessingResultsFetchOptionals struct {
+	Bookmark string
+	PageSize int
+}
+
+// Fetch loads the results of processing a product feed, most recent first
+// Endpoint: [GET] /v5/catalogs/feeds/<feed_id>/processing_results
+func (cfprc *CatalogsFeedsProcessingResultsController) Fetch(feedId string, optionals *CatalogsFeedsProcessingResultsFetchOptionals) (*[]models.FeedProcessingResult, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &CatalogsFeedsProcessingResultsFetchOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("catalogs", "feeds").Id("feed", feedId).Segment("processing_results").Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.FeedProcessingResult{}
+	request := cfprc.restClient.Get(path).Operation("Catalogs.Feeds.ProcessingResults.Fetch").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.FeedProcessingResult), &models.Page{Bookmark: resp.Bookmark}, nil
+}
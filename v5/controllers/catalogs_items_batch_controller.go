@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// CatalogsItemsBatchController is the controller that is responsible for all
+// /v5/catalogs/items/batch endpoints in the Pinterest API.
+type CatalogsItemsBatchController struct {
+	restClient *rest.Client
+}
+
+// newCatalogsItemsBatchController instantiates a new CatalogsItemsBatchController.
+func newCatalogsItemsBatchController(rc *rest.Client) *CatalogsItemsBatchController {
+	return &CatalogsItemsBatchController{
+		restClient: rc,
+	}
+}
+
+// Create upserts catalog items for a country and language (such as US
+// and EN).  Items are sent in batches of up to models.MAX_ITEMS_PER_BATCH,
+// and a batch is returned for each, to be fetched once it has been
+// processed for the outcome of each item.
+//
+// If a batch fails to be sent, the batches that were already sent are
+// returned along with the error.
+// Endpoint: [POST] /v5/catalogs/items/batch
+func (cibc *CatalogsItemsBatchController) Create(country string, language string, items []models.CatalogItem) (*[]models.ItemsBatch, error) {
+	batches := []models.ItemsBatch{}
+	for start := 0; start < len(items); start += models.MAX_ITEMS_PER_BATCH {
+		end := start + models.MAX_ITEMS_PER_BATCH
+		if end > len(items) {
+			end = len(items)
+		}
+
+		// Build + execute request
+		resp := new(models.Response)
+		resp.Data = new(models.ItemsBatch)
+		httpResp, err := cibc.restClient.Post("/catalogs/items/batch").Operation("Catalogs.Items.Batch.Create").
+			JSONBody(map[string]interface{}{
+				"country":   country,
+				"language":  language,
+				"operation": "UPSERT",
+				"items":     items[start:end],
+			}).
+			Into(resp).
+			Execute()
+
+		// Check Error
+		if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+			return &batches, err
+		}
+		batches = append(batches, *resp.Data.(*models.ItemsBatch))
+	}
+
+	// OK
+	return &batches, nil
+}
+
+// Fetch loads the status of a batch, and the outcome of each
+// of its items once it has been processed
+// Endpoint: [GET] /v5/catalogs/items/batch/<batch_id>
+func (cibc *CatalogsItemsBatchController) Fetch(batchId string) (*models.ItemsBatch, error) {
+	// Build path
+	path, err := endpoint.NewV5("catalogs", "items", "batch").Key(endpoint.BatchId, batchId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.ItemsBatch)
+	httpResp, err := cibc.restClient.Get(path).Operation("Catalogs.Items.Batch.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.ItemsBatch), nil
+}
@@ -0,0 +1,20 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/rest"
+)
+
+// CatalogsItemsController is the controller that is responsible for all
+// /v5/catalogs/items endpoints in the Pinterest API.
+type CatalogsItemsController struct {
+	restClient *rest.Client
+	Batch      *CatalogsItemsBatchController
+}
+
+// newCatalogsItemsController instantiates a new CatalogsItemsController.
+func newCatalogsItemsController(rc *rest.Client) *CatalogsItemsController {
+	return &CatalogsItemsController{
+		restClient: rc,
+		Batch:      newCatalogsItemsBatchController(rc),
+	}
+}
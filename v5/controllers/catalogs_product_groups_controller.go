@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// CatalogsProductGroupsController is the controller that is responsible for all
+// /v5/catalogs/product_groups endpoints in the Pinterest API.
+type CatalogsProductGroupsController struct {
+	restClient *rest.Client
+}
+
+// newCatalogsProductGroupsController instantiates a new CatalogsProductGroupsController.
+func newCatalogsProductGroupsController(rc *rest.Client) *CatalogsProductGroupsController {
+	return &CatalogsProductGroupsController{
+		restClient: rc,
+	}
+}
+
+// CatalogsProductGroupsListOptionals is a struct that represents the optional parameters
+// that can be passed to the List endpoint
+type CatalogsProductGroupsListOptionals struct {
+	Bookmark string
+	PageSize int
+	FeedId   string
+}
+
+// List loads the authorized user's product groups
+// Endpoint: [GET] /v5/catalogs/product_groups
+func (cpgc *CatalogsProductGroupsController) List(optionals *CatalogsProductGroupsListOptionals) (*[]models.ProductGroup, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &CatalogsProductGroupsListOptionals{}
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.ProductGroup{}
+	request := cpgc.restClient.Get("/catalogs/product_groups").Operation("Catalogs.ProductGroups.List").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	if optionals.FeedId != "" {
+		request.URLParam("feed_id", optionals.FeedId)
+	}
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.ProductGroup), &models.Page{Bookmark: resp.Bookmark}, nil
+}
+
+// Fetch loads a product group from its id
+// Endpoint: [GET] /v5/catalogs/product_groups/<product_group_id>
+func (cpgc *CatalogsProductGroupsController) Fetch(productGroupId string) (*models.ProductGroup, error) {
+	// Build path
+	path, err := endpoint.NewV5("catalogs", "product_groups").Id("product group", productGroupId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.ProductGroup)
+	httpResp, err := cpgc.restClient.Get(path).Operation("Catalogs.ProductGroups.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.ProductGroup), nil
+}
+
+// ProductGroupCreateOptionals is a struct that represents the optional parameters
+// that can be passed to the Create endpoint
+type ProductGroupCreateOptionals struct {
+	Description OptionalString
+	FeedId      OptionalString
+}
+
+// Create creates a new product group of the products selected by filters,
+// which is encoded as JSON (such as a map, or a json.RawMessage)
+// Endpoint: [POST] /v5/catalogs/product_groups
+func (cpgc *CatalogsProductGroupsController) Create(name string, filters interface{}, optionals *ProductGroupCreateOptionals) (*models.ProductGroup, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &ProductGroupCreateOptionals{}
+	}
+	if err := validateOptionalId("feed", optionals.FeedId); err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{
+		"name":    name,
+		"filters": filters,
+	}
+	setIfSet(body, "description", optionals.Description)
+	setIfSet(body, "feed_id", optionals.FeedId)
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.ProductGroup)
+	httpResp, err := cpgc.restClient.Post("/catalogs/product_groups").Operation("Catalogs.ProductGroups.Create").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.ProductGroup), nil
+}
+
+// ProductGroupUpdateOptionals is a struct that represents the optional parameters
+// that can be passed to the Update endpoint
+type ProductGroupUpdateOptionals struct {
+	Name        OptionalString
+	Description OptionalString
+
+	// Filters replaces the product group's filters, if it is not nil.
+	Filters interface{}
+}
+
+// Update updates an existing product group
+// Endpoint: [PATCH] /v5/catalogs/product_groups/<product_group_id>
+func (cpgc *CatalogsProductGroupsController) Update(productGroupId string, optionals *ProductGroupUpdateOptionals) (*models.ProductGroup, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &ProductGroupUpdateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("catalogs", "product_groups").Id("product group", productGroupId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{}
+	setIfSet(body, "name", optionals.Name)
+	setIfSet(body, "description", optionals.Description)
+	if optionals.Filters != nil {
+		body["filters"] = optionals.Filters
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.ProductGroup)
+	httpResp, err := cpgc.restClient.Patch(path).Operation("Catalogs.ProductGroups.Update").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.ProductGroup), nil
+}
+
+// Delete deletes an existing product group
+// Endpoint: [DELETE] /v5/catalogs/product_groups/<product_group_id>
+func (cpgc *CatalogsProductGroupsController) Delete(productGroupId string) error {
+	// Build path
+	path, err := endpoint.NewV5("catalogs", "product_groups").Id("product group", productGroupId).Build()
+	if err != nil {
+		return err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	httpResp, err := cpgc.restClient.Delete(path).Operation("Catalogs.ProductGroups.Delete").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return err
+	}
+
+	// OK
+	return nil
+}
@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"sort"
+
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// MediaController is the controller that is responsible for all
+// /v5/media endpoints in the Pinterest API.
+type MediaController struct {
+	restClient   *rest.Client
+	uploadClient *rest.Client
+}
+
+// NewMediaController instantiates a new MediaController.
+//
+// Files are uploaded with uploadClient, which must not authorize
+// requests, as they are sent to the storage host rather than Pinterest.
+func NewMediaController(rc *rest.Client, uploadClient *rest.Client) *MediaController {
+	return &MediaController{
+		restClient:   rc,
+		uploadClient: uploadClient,
+	}
+}
+
+// Create registers new media, which returns where its file
+// should be uploaded to
+// Endpoint: [POST] /v5/media
+func (mc *MediaController) Create(mediaType string) (*models.MediaUpload, error) {
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.MediaUpload)
+	httpResp, err := mc.restClient.Post("/media").Operation("Media.Create").
+		JSONBody(map[string]interface{}{
+			"media_type": mediaType,
+		}).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.MediaUpload), nil
+}
+
+// Fetch loads the processing status of registered media
+// Endpoint: [GET] /v5/media/<media_id>
+func (mc *MediaController) Fetch(mediaId string) (*models.Media, error) {
+	// Build path
+	path, err := endpoint.NewV5("media").Id("media", mediaId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Media)
+	httpResp, err := mc.restClient.Get(path).Operation("Media.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Media), nil
+}
+
+// Upload uploads the file of registered media to its upload URL.
+//
+// size must be the exact length of file.  progress, which may be nil,
+// is called as the file is sent with the number of bytes sent so far.
+func (mc *MediaController) Upload(upload *models.MediaUpload, file io.Reader, size int64, progress func(sent int64)) error {
+	// Build the multipart form around the file, so its length is known
+	head := new(bytes.Buffer)
+	writer := multipart.NewWriter(head)
+	keys := make([]string, 0, len(upload.UploadParameters))
+	for key := range upload.UploadParameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := writer.WriteField(key, upload.UploadParameters[key]); err != nil {
+			return err
+		}
+	}
+	if _, err := writer.CreateFormFile("file", upload.MediaId); err != nil {
+		return err
+	}
+	tail := new(bytes.Buffer)
+	tail.WriteString("\r\n--" + writer.Boundary() + "--\r\n")
+
+	// Build + execute request
+	body := io.MultiReader(head, &progressReader{reader: file, progress: progress}, tail)
+	req, err := http.NewRequest("POST", upload.UploadUrl, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(head.Len()) + size + int64(tail.Len())
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	httpResp, err := mc.uploadClient.Do(req, "Media.Upload")
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	// Check Error
+	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
+		message, _ := ioutil.ReadAll(io.LimitReader(httpResp.Body, 1024))
+		return &models.PinterestError{
+			StatusCode: httpResp.StatusCode,
+			Message:    "media upload failed: " + string(message),
+		}
+	}
+
+	// OK
+	return nil
+}
+
+// progressReader reports how much of a reader has been read.
+type progressReader struct {
+	reader   io.Reader
+	sent     int64
+	progress func(sent int64)
+}
+
+func (pr *progressReader) Read(p []byte) (int, error) {
+	n, err := pr.reader.Read(p)
+	if n > 0 {
+		pr.sent += int64(n)
+		if pr.progress != nil {
+			pr.progress(pr.sent)
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,20 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/rest"
+)
+
+// OAuthController is the controller that is responsible
+// for all /v5/oauth endpoints in the Pinterest API.
+type OAuthController struct {
+	restClient *rest.Client
+	Token      *OAuthTokenController
+}
+
+// NewOAuthController instantiates a new OAuthController
+func NewOAuthController(rc *rest.Client) *OAuthController {
+	return &OAuthController{
+		restClient: rc,
+		Token:      newOAuthTokenController(rc),
+	}
+}
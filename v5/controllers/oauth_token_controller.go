@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"encoding/base64"
+	"net/url"
+
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// OAuthTokenController is the controller that is responsible
+// for all /v5/oauth/token endpoints in the Pinterest API.
+type OAuthTokenController struct {
+	restClient *rest.Client
+}
+
+// newOAuthTokenController instantiates a new OAuthTokenController
+func newOAuthTokenController(rc *rest.Client) *OAuthTokenController {
+	return &OAuthTokenController{
+		restClient: rc,
+	}
+}
+
+// Create exchanges an authorization code for an access token
+// Endpoint: [POST] /v5/oauth/token
+func (otc *OAuthTokenController) Create(clientId, clientSecret, code, redirectUri string) (*models.AccessToken, error) {
+	return otc.exchange("OAuth.Token.Create", clientId, clientSecret, url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {redirectUri},
+	})
+}
+
+// Refresh exchanges a refresh token for a new access token
+// Endpoint: [POST] /v5/oauth/token
+func (otc *OAuthTokenController) Refresh(clientId, clientSecret, refreshToken string) (*models.AccessToken, error) {
+	return otc.exchange("OAuth.Token.Refresh", clientId, clientSecret, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+}
+
+// exchange requests a token, authorizing the client with HTTP Basic
+// auth rather than sending its credentials in the query string.
+func (otc *OAuthTokenController) exchange(operation string, clientId, clientSecret string, form url.Values) (*models.AccessToken, error) {
+	// Build + execute request
+	credentials := base64.StdEncoding.EncodeToString([]byte(clientId + ":" + clientSecret))
+	resp := new(models.Response)
+	resp.Data = new(models.AccessToken)
+	httpResp, err := otc.restClient.Post("/oauth/token").Operation(operation).
+		Header("Authorization", "Basic "+credentials).
+		FormBody(form).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.AccessToken), nil
+}
@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	v1 "github.com/carrot/go-pinterest/controllers"
+	"github.com/carrot/go-pinterest/internal/rest"
+	v1models "github.com/carrot/go-pinterest/models"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// OptionalString is the same as the v1 controllers' OptionalString:
+// it is only sent to the Pinterest API when it has been explicitly set.
+type OptionalString = v1.OptionalString
+
+// String returns an OptionalString that is set to value.
+func String(value string) OptionalString {
+	return v1.String(value)
+}
+
+// OptionalMicroCurrency is an amount of money that is only sent to the
+// Pinterest API when it has been explicitly set.  It may be set to an
+// amount with Money, or set to no amount with NoLimit (such as to remove
+// a campaign's spend cap).
+type OptionalMicroCurrency struct {
+	value *models.MicroCurrency
+	set   bool
+}
+
+// Money returns an OptionalMicroCurrency that is set to amount.
+func Money(amount models.MicroCurrency) OptionalMicroCurrency {
+	return OptionalMicroCurrency{value: &amount, set: true}
+}
+
+// NoLimit returns an OptionalMicroCurrency that is set to no amount.
+func NoLimit() OptionalMicroCurrency {
+	return OptionalMicroCurrency{set: true}
+}
+
+// Get returns the amount (nil for NoLimit), and whether it has been set.
+func (o OptionalMicroCurrency) Get() (*models.MicroCurrency, bool) {
+	return o.value, o.set
+}
+
+// IsSet returns true if the OptionalMicroCurrency has been explicitly set.
+func (o OptionalMicroCurrency) IsSet() bool {
+	return o.set
+}
+
+// setIfSet adds the OptionalString to a JSON body, only if it has been set.
+func setIfSet(body map[string]interface{}, key string, optional OptionalString) {
+	if value, ok := optional.Get(); ok {
+		body[key] = value
+	}
+}
+
+// setMoneyIfSet adds the OptionalMicroCurrency to a JSON body, only if
+// it has been set.  NoLimit is sent as null.
+func setMoneyIfSet(body map[string]interface{}, key string, optional OptionalMicroCurrency) {
+	if value, ok := optional.Get(); ok {
+		if value == nil {
+			body[key] = nil
+		} else {
+			body[key] = int64(*value)
+		}
+	}
+}
+
+// setTimeIfSet adds the time to a JSON body as a unix timestamp,
+// only if it is not nil.
+func setTimeIfSet(body map[string]interface{}, key string, t *time.Time) {
+	if t != nil {
+		body[key] = t.Unix()
+	}
+}
+
+// pageParams adds the bookmark and page size of a paginated
+// list to the request, if they are set.
+func pageParams(request *rest.Request, bookmark string, pageSize int) {
+	if bookmark != "" {
+		request.URLParam("bookmark", bookmark)
+	}
+	if pageSize != 0 {
+		request.URLParam("page_size", strconv.Itoa(pageSize))
+	}
+}
+
+// dateRange formats a range of dates, or returns an error
+// if the range ends before it starts.
+func dateRange(startDate time.Time, endDate time.Time) (string, string, error) {
+	start := models.Date{Time: startDate}.String()
+	end := models.Date{Time: endDate}.String()
+	if end < start {
+		return "", "", fmt.Errorf("invalid date range: %s is before %s", end, start)
+	}
+	return start, end, nil
+}
+
+// validateId returns an error if id is not a well formed id.
+func validateId(kind string, id string) error {
+	if !v1models.IsValidId(id) {
+		return fmt.Errorf("invalid %s id %q", kind, id)
+	}
+	return nil
+}
+
+// validateOptionalId returns an error if the OptionalString is set,
+// but is not a well formed id.
+func validateOptionalId(kind string, optional OptionalString) error {
+	if id, ok := optional.Get(); ok {
+		return validateId(kind, id)
+	}
+	return nil
+}
+
+// executeBulk sends an ads API request, whose body is a list of a single
+// entity, and decodes the entity returned for it into v.
+func executeBulk(request *rest.Request, v interface{}) error {
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.BulkItem{}
+	httpResp, err := request.
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return err
+	}
+
+	// OK
+	return models.DecodeBulkItem(httpResp, *resp.Data.(*[]models.BulkItem), v)
+}
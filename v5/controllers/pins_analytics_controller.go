@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// PinsAnalyticsController is the controller that is responsible for all
+// /v5/pins/<pin_id>/analytics endpoints in the Pinterest API.
+type PinsAnalyticsController struct {
+	restClient *rest.Client
+}
+
+// newPinsAnalyticsController instantiates a new PinsAnalyticsController.
+func newPinsAnalyticsController(rc *rest.Client) *PinsAnalyticsController {
+	return &PinsAnalyticsController{
+		restClient: rc,
+	}
+}
+
+// PinsAnalyticsFetchOptionals is a struct that represents the optional parameters
+// that can be passed to the Fetch endpoint
+type PinsAnalyticsFetchOptionals struct {
+	AppTypes string
+}
+
+// Fetch loads a pin's metrics over a range of dates
+// Endpoint: [GET] /v5/pins/<pin_id>/analytics
+func (pac *PinsAnalyticsController) Fetch(pinId string, startDate time.Time, endDate time.Time, metricTypes []string, optionals *PinsAnalyticsFetchOptionals) (*models.Analytics, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &PinsAnalyticsFetchOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("pins").Id("pin", pinId).Segment("analytics").Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate dates
+	start, end, err := dateRange(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	analytics := map[string]*models.Analytics{}
+	resp := new(models.Response)
+	resp.Data = &analytics
+	request := pac.restClient.Get(path).Operation("Pins.Analytics.Fetch").
+		URLParam("start_date", start).
+		URLParam("end_date", end).
+		URLParam("metric_types", strings.Join(metricTypes, ",")).
+		Into(resp)
+	if optionals.AppTypes != "" {
+		request.URLParam("app_types", optionals.AppTypes)
+	}
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return allAnalytics(analytics), nil
+}
@@ -0,0 +1,268 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// PinsController is the controller that is responsible for all
+// /v5/pins endpoints in the Pinterest API.
+type PinsController struct {
+	restClient *rest.Client
+	Analytics  *PinsAnalyticsController
+}
+
+// NewPinsController instantiates a new PinsController.
+func NewPinsController(rc *rest.Client) *PinsController {
+	return &PinsController{
+		restClient: rc,
+		Analytics:  newPinsAnalyticsController(rc),
+	}
+}
+
+// PinsListOptionals is a struct that represents the optional parameters
+// that can be passed to the List endpoint
+type PinsListOptionals struct {
+	Bookmark string
+	PageSize int
+}
+
+// List loads the authorized user's pins
+// Endpoint: [GET] /v5/pins
+func (pc *PinsController) List(optionals *PinsListOptionals) (*[]models.Pin, *models.Page, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &PinsListOptionals{}
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = &[]models.Pin{}
+	request := pc.restClient.Get("/pins").Operation("Pins.List").
+		Into(resp)
+	pageParams(request, optionals.Bookmark, optionals.PageSize)
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, nil, err
+	}
+
+	// OK
+	return resp.Data.(*[]models.Pin), &models.Page{Bookmark: resp.Bookmark}, nil
+}
+
+// Fetch loads a pin from its id
+// Endpoint: [GET] /v5/pins/<pin_id>
+func (pc *PinsController) Fetch(pinId string) (*models.Pin, error) {
+	// Build path
+	path, err := endpoint.NewV5("pins").Id("pin", pinId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Pin)
+	httpResp, err := pc.restClient.Get(path).Operation("Pins.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Pin), nil
+}
+
+// PinCreateOptionals is a struct that represents the optional parameters
+// that can be passed to the Create endpoint
+type PinCreateOptionals struct {
+	Title          OptionalString
+	Description    OptionalString
+	Link           OptionalString
+	AltText        OptionalString
+	BoardSectionId OptionalString
+}
+
+// Create creates a new pin on a board
+// Endpoint: [POST] /v5/pins
+func (pc *PinsController) Create(boardId string, mediaSource models.PinMediaSource, optionals *PinCreateOptionals) (*models.Pin, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &PinCreateOptionals{}
+	}
+
+	// Validate ids
+	if err := validateId("board", boardId); err != nil {
+		return nil, err
+	}
+	if err := validateOptionalId("section", optionals.BoardSectionId); err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{
+		"board_id":     boardId,
+		"media_source": mediaSource,
+	}
+	setIfSet(body, "title", optionals.Title)
+	setIfSet(body, "description", optionals.Description)
+	setIfSet(body, "link", optionals.Link)
+	setIfSet(body, "alt_text", optionals.AltText)
+	setIfSet(body, "board_section_id", optionals.BoardSectionId)
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Pin)
+	httpResp, err := pc.restClient.Post("/pins").Operation("Pins.Create").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Pin), nil
+}
+
+// PinUpdateOptionals is a struct that represents the optional parameters
+// that can be passed to the Update endpoint
+type PinUpdateOptionals struct {
+	BoardId        OptionalString
+	BoardSectionId OptionalString
+	Title          OptionalString
+	Description    OptionalString
+	Link           OptionalString
+	AltText        OptionalString
+}
+
+// Update updates an existing pin
+// Endpoint: [PATCH] /v5/pins/<pin_id>
+func (pc *PinsController) Update(pinId string, optionals *PinUpdateOptionals) (*models.Pin, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &PinUpdateOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("pins").Id("pin", pinId).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate ids
+	if err := validateOptionalId("board", optionals.BoardId); err != nil {
+		return nil, err
+	}
+	if err := validateOptionalId("section", optionals.BoardSectionId); err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{}
+	setIfSet(body, "board_id", optionals.BoardId)
+	setIfSet(body, "board_section_id", optionals.BoardSectionId)
+	setIfSet(body, "title", optionals.Title)
+	setIfSet(body, "description", optionals.Description)
+	setIfSet(body, "link", optionals.Link)
+	setIfSet(body, "alt_text", optionals.AltText)
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Pin)
+	httpResp, err := pc.restClient.Patch(path).Operation("Pins.Update").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Pin), nil
+}
+
+// Delete deletes an existing pin
+// Endpoint: [DELETE] /v5/pins/<pin_id>
+func (pc *PinsController) Delete(pinId string) error {
+	// Build path
+	path, err := endpoint.NewV5("pins").Id("pin", pinId).Build()
+	if err != nil {
+		return err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	httpResp, err := pc.restClient.Delete(path).Operation("Pins.Delete").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return err
+	}
+
+	// OK
+	return nil
+}
+
+// PinSaveOptionals is a struct that represents the optional parameters
+// that can be passed to the Save endpoint
+type PinSaveOptionals struct {
+	BoardSectionId OptionalString
+}
+
+// Save saves (repins) an existing pin to one of the authorized
+// user's boards, and returns the newly created pin.
+// Endpoint: [POST] /v5/pins/<pin_id>/save
+func (pc *PinsController) Save(pinId string, boardId string, optionals *PinSaveOptionals) (*models.Pin, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &PinSaveOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("pins").Id("pin", pinId).Segment("save").Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate ids
+	if err := validateId("board", boardId); err != nil {
+		return nil, err
+	}
+	if err := validateOptionalId("section", optionals.BoardSectionId); err != nil {
+		return nil, err
+	}
+
+	// Build body
+	body := map[string]interface{}{
+		"board_id": boardId,
+	}
+	setIfSet(body, "board_section_id", optionals.BoardSectionId)
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.Pin)
+	httpResp, err := pc.restClient.Post(path).Operation("Pins.Save").
+		JSONBody(body).
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.Pin), nil
+}
@@ -0,0 +1,20 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/rest"
+)
+
+// TrendsController is the controller that is responsible for all
+// /v5/trends endpoints in the Pinterest API.
+type TrendsController struct {
+	restClient *rest.Client
+	Keywords   *TrendsKeywordsController
+}
+
+// NewTrendsController instantiates a new TrendsController.
+func NewTrendsController(rc *rest.Client) *TrendsController {
+	return &TrendsController{
+		restClient: rc,
+		Keywords:   newTrendsKeywordsController(rc),
+	}
+}
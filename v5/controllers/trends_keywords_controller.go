@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/carrot/go-pinterest/internal/endpoint"
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// TrendsKeywordsController is the controller that is responsible for all
+// /v5/trends/keywords endpoints in the Pinterest API.
+type TrendsKeywordsController struct {
+	restClient *rest.Client
+}
+
+// newTrendsKeywordsController instantiates a new TrendsKeywordsController.
+func newTrendsKeywordsController(rc *rest.Client) *TrendsKeywordsController {
+	return &TrendsKeywordsController{
+		restClient: rc,
+	}
+}
+
+// TrendsKeywordsListOptionals is a struct that represents the optional parameters
+// that can be passed to the List endpoint
+type TrendsKeywordsListOptionals struct {
+	// Interests, Genders and Ages narrow the trends to
+	// the searches of an audience.
+	Interests []string
+	Genders   []string
+	Ages      []string
+
+	// IncludeKeywords only returns trends that contain one of the keywords.
+	IncludeKeywords []string
+
+	// NormalizeAgainstGroup scales every keyword's time series against the
+	// keyword with the most searches, rather than against itself, so that
+	// the series can be compared on the same chart.
+	NormalizeAgainstGroup bool
+
+	Limit int
+}
+
+// List loads the top trending keywords in a region (such as US or GB+IE),
+// ranked over a time window such as models.TREND_TYPE_MONTHLY
+// Endpoint: [GET] /v5/trends/keywords/<region>/top/<trend_type>
+func (tkc *TrendsKeywordsController) List(region string, trendType string, optionals *TrendsKeywordsListOptionals) (*[]models.TrendingKeyword, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &TrendsKeywordsListOptionals{}
+	}
+
+	// Build path
+	path, err := endpoint.NewV5("trends", "keywords").Key(endpoint.Region, region).
+		Segment("top").Key(endpoint.TrendType, trendType).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.TrendingKeywords)
+	request := tkc.restClient.Get(path).Operation("Trends.Keywords.List").
+		Into(resp)
+	if len(optionals.Interests) > 0 {
+		request.URLParam("interests", strings.Join(optionals.Interests, ","))
+	}
+	if len(optionals.Genders) > 0 {
+		request.URLParam("genders", strings.Join(optionals.Genders, ","))
+	}
+	if len(optionals.Ages) > 0 {
+		request.URLParam("ages", strings.Join(optionals.Ages, ","))
+	}
+	if len(optionals.IncludeKeywords) > 0 {
+		request.URLParam("include_keywords", strings.Join(optionals.IncludeKeywords, ","))
+	}
+	if optionals.NormalizeAgainstGroup {
+		request.URLParam("normalize_against_group", "true")
+	}
+	if optionals.Limit > 0 {
+		request.URLParam("limit", strconv.Itoa(optionals.Limit))
+	}
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return &resp.Data.(*models.TrendingKeywords).Trends, nil
+}
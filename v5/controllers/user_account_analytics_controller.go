@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// UserAccountAnalyticsController is the controller that is responsible for all
+// /v5/user_account/analytics endpoints in the Pinterest API.
+type UserAccountAnalyticsController struct {
+	restClient *rest.Client
+}
+
+// newUserAccountAnalyticsController instantiates a new UserAccountAnalyticsController.
+func newUserAccountAnalyticsController(rc *rest.Client) *UserAccountAnalyticsController {
+	return &UserAccountAnalyticsController{
+		restClient: rc,
+	}
+}
+
+// UserAccountAnalyticsFetchOptionals is a struct that represents the optional parameters
+// that can be passed to the Fetch endpoint
+type UserAccountAnalyticsFetchOptionals struct {
+	MetricTypes []string
+	AppTypes    string
+	AdAccountId string
+}
+
+// Fetch loads the authorized user's metrics over a range of dates
+// Endpoint: [GET] /v5/user_account/analytics
+func (uaac *UserAccountAnalyticsController) Fetch(startDate time.Time, endDate time.Time, optionals *UserAccountAnalyticsFetchOptionals) (*models.Analytics, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &UserAccountAnalyticsFetchOptionals{}
+	}
+
+	// Validate dates
+	start, end, err := dateRange(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build + execute request
+	analytics := map[string]*models.Analytics{}
+	resp := new(models.Response)
+	resp.Data = &analytics
+	request := uaac.restClient.Get("/user_account/analytics").Operation("UserAccount.Analytics.Fetch").
+		URLParam("start_date", start).
+		URLParam("end_date", end).
+		Into(resp)
+	if len(optionals.MetricTypes) > 0 {
+		request.URLParam("metric_types", strings.Join(optionals.MetricTypes, ","))
+	}
+	if optionals.AppTypes != "" {
+		request.URLParam("app_types", optionals.AppTypes)
+	}
+	if optionals.AdAccountId != "" {
+		request.URLParam("ad_account_id", optionals.AdAccountId)
+	}
+	httpResp, err := request.Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return allAnalytics(analytics), nil
+}
+
+// allAnalytics returns the metrics of a response that was not split
+// by any field, which are keyed as "all".
+func allAnalytics(analytics map[string]*models.Analytics) *models.Analytics {
+	if all, ok := analytics["all"]; ok && all != nil {
+		return all
+	}
+	return &models.Analytics{}
+}
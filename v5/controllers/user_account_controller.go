@@ -0,0 +1,40 @@
+package controllers
+
+import (
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// UserAccountController is the controller that is responsible for all
+// /v5/user_account endpoints in the Pinterest API.
+type UserAccountController struct {
+	restClient *rest.Client
+	Analytics  *UserAccountAnalyticsController
+}
+
+// NewUserAccountController instantiates a new UserAccountController.
+func NewUserAccountController(rc *rest.Client) *UserAccountController {
+	return &UserAccountController{
+		restClient: rc,
+		Analytics:  newUserAccountAnalyticsController(rc),
+	}
+}
+
+// Fetch loads the authorized user's account
+// Endpoint: [GET] /v5/user_account
+func (uac *UserAccountController) Fetch() (*models.UserAccount, error) {
+	// Build + execute request
+	resp := new(models.Response)
+	resp.Data = new(models.UserAccount)
+	httpResp, err := uac.restClient.Get("/user_account").Operation("UserAccount.Fetch").
+		Into(resp).
+		Execute()
+
+	// Check Error
+	if err = models.WrapPinterestError(httpResp, resp, err); err != nil {
+		return nil, err
+	}
+
+	// OK
+	return resp.Data.(*models.UserAccount), nil
+}
@@ -0,0 +1,193 @@
+package v5
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/carrot/go-pinterest/v5/controllers"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// ConversionsSenderOptionals is a struct that represents the optional
+// parameters that can be passed to NewConversionsSender
+type ConversionsSenderOptionals struct {
+	// BatchSize is how many events are sent per request.  Events are
+	// sent as soon as this many are queued.  Defaults to (and can't be
+	// more than) models.MAX_CONVERSION_EVENTS_PER_REQUEST.
+	BatchSize int
+
+	// FlushInterval is the longest an event is queued before it is sent.
+	// Defaults to 5 seconds.
+	FlushInterval time.Duration
+
+	// Test sends the events as test events, which are
+	// validated by Pinterest but not recorded.
+	Test bool
+
+	// OnFailure is called with the events of each batch that were not
+	// processed, either because Pinterest rejected them or because
+	// their request failed.  It may be called from another goroutine.
+	OnFailure func(failures []ConversionFailure)
+}
+
+// ConversionFailure is a conversion event that was not processed,
+// along with why.
+type ConversionFailure struct {
+	Event models.ConversionEvent
+	Err   error
+}
+
+// ErrConversionsSenderClosed is returned when an event is
+// sent through a ConversionsSender that was closed.
+var ErrConversionsSenderClosed = errors.New("the conversions sender is closed")
+
+// ConversionsSender queues conversion events for an ad account, and sends
+// them to the Conversions API in batches.  A batch is sent when it is full,
+// when it has been queued for the flush interval, or on Flush and Close.
+//
+// It is safe to use from multiple goroutines.  Close must be called
+// when done, so the events still queued are sent.
+type ConversionsSender struct {
+	events        *controllers.AdAccountsEventsController
+	adAccountId   string
+	batchSize     int
+	flushInterval time.Duration
+	test          bool
+	onFailure     func(failures []ConversionFailure)
+
+	mutex   sync.Mutex
+	pending []models.ConversionEvent
+	timer   *time.Timer
+	closed  bool
+
+	// sending tracks the batches being sent, so Close can wait for them
+	sending sync.WaitGroup
+}
+
+// NewConversionsSender returns a ConversionsSender that sends
+// conversion events for the ad account.
+func (pc *Client) NewConversionsSender(adAccountId string, optionals *ConversionsSenderOptionals) *ConversionsSender {
+	// Default optionals
+	if optionals == nil {
+		optionals = &ConversionsSenderOptionals{}
+	}
+	batchSize := optionals.BatchSize
+	if batchSize <= 0 || batchSize > models.MAX_CONVERSION_EVENTS_PER_REQUEST {
+		batchSize = models.MAX_CONVERSION_EVENTS_PER_REQUEST
+	}
+	flushInterval := optionals.FlushInterval
+	if flushInterval <= 0 {
+		flushInterval = 5 * time.Second
+	}
+
+	return &ConversionsSender{
+		events:        pc.AdAccounts.Events,
+		adAccountId:   adAccountId,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		test:          optionals.Test,
+		onFailure:     optionals.OnFailure,
+	}
+}
+
+// Send validates an event and queues it to be sent.  An invalid event is
+// returned as an error, and never queued.
+//
+// If the event fills the batch, the batch is sent before Send returns,
+// and the error of its request (if any) is returned as well as being
+// passed to OnFailure.
+func (s *ConversionsSender) Send(event models.ConversionEvent) error {
+	if err := event.Validate(); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		return ErrConversionsSenderClosed
+	}
+	s.pending = append(s.pending, event)
+	if len(s.pending) >= s.batchSize {
+		batch := s.takePending()
+		s.mutex.Unlock()
+		return s.send(batch)
+	}
+	if s.timer == nil {
+		s.timer = time.AfterFunc(s.flushInterval, func() { s.Flush() })
+	}
+	s.mutex.Unlock()
+	return nil
+}
+
+// Flush sends the queued events now, returning the error of their
+// request (if any), which is also passed to OnFailure.
+func (s *ConversionsSender) Flush() error {
+	s.mutex.Lock()
+	batch := s.takePending()
+	s.mutex.Unlock()
+	return s.send(batch)
+}
+
+// Close sends the queued events, and stops the sender from accepting
+// any more.  It returns once every batch has been sent, including those
+// that were already being sent (such as by the flush timer).
+func (s *ConversionsSender) Close() error {
+	s.mutex.Lock()
+	s.closed = true
+	batch := s.takePending()
+	s.mutex.Unlock()
+	err := s.send(batch)
+	s.sending.Wait()
+	return err
+}
+
+// takePending removes and returns the queued events, and stops
+// the flush timer.  The mutex must be held, and the batch must
+// then be passed to send.
+func (s *ConversionsSender) takePending() []models.ConversionEvent {
+	batch := s.pending
+	s.pending = nil
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if len(batch) > 0 {
+		s.sending.Add(1)
+	}
+	return batch
+}
+
+// send sends a batch of events, and reports the ones that were not processed.
+func (s *ConversionsSender) send(batch []models.ConversionEvent) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	defer s.sending.Done()
+
+	result, err := s.events.Create(s.adAccountId, batch, &controllers.AdAccountsEventsCreateOptionals{
+		Test: s.test,
+	})
+	var failures []ConversionFailure
+	if err != nil {
+		for _, event := range batch {
+			failures = append(failures, ConversionFailure{Event: event, Err: err})
+		}
+	} else {
+		for i, status := range result.Events {
+			if i >= len(batch) || !status.Failed() {
+				continue
+			}
+			message := status.ErrorMessage
+			if message == "" {
+				message = "the event was not processed"
+			}
+			failures = append(failures, ConversionFailure{Event: batch[i], Err: errors.New(message)})
+		}
+	}
+
+	if len(failures) > 0 && s.onFailure != nil {
+		s.onFailure(failures)
+	}
+	return err
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AccessToken is a struct that represents an OAuth token
+// response from the Pinterest v5 API.
+//
+// ExpiresAt and RefreshTokenExpiresAt are computed from ExpiresIn and
+// RefreshTokenExpiresIn when the token is decoded, and are kept when
+// the token is encoded, so a persisted token remembers when it expires.
+type AccessToken struct {
+	AccessToken           string    `json:"access_token"`
+	RefreshToken          string    `json:"refresh_token,omitempty"`
+	TokenType             string    `json:"token_type"`
+	Scope                 string    `json:"scope"`
+	ExpiresIn             int64     `json:"expires_in"`
+	RefreshTokenExpiresIn int64     `json:"refresh_token_expires_in,omitempty"`
+	ExpiresAt             time.Time `json:"expires_at"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+	Raw                   Raw       `json:"-"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *AccessToken) UnmarshalJSON(data []byte) error {
+	type accessToken AccessToken
+	if err := json.Unmarshal(data, (*accessToken)(m)); err != nil {
+		return err
+	}
+
+	// Track expiry, unless the token was persisted with it
+	now := time.Now()
+	if m.ExpiresAt.IsZero() && m.ExpiresIn > 0 {
+		m.ExpiresAt = now.Add(time.Duration(m.ExpiresIn) * time.Second)
+	}
+	if m.RefreshTokenExpiresAt.IsZero() && m.RefreshTokenExpiresIn > 0 {
+		m.RefreshTokenExpiresAt = now.Add(time.Duration(m.RefreshTokenExpiresIn) * time.Second)
+	}
+	return nil
+}
+
+// ExpiresWithin returns true if the access token expires within d.
+// Tokens without a known expiry never expire.
+func (m *AccessToken) ExpiresWithin(d time.Duration) bool {
+	return !m.ExpiresAt.IsZero() && time.Now().Add(d).After(m.ExpiresAt)
+}
+
+// CanRefresh returns true if the token has a refresh token
+// that has not expired.
+func (m *AccessToken) CanRefresh() bool {
+	if m.RefreshToken == "" {
+		return false
+	}
+	return m.RefreshTokenExpiresAt.IsZero() || time.Now().Before(m.RefreshTokenExpiresAt)
+}
@@ -0,0 +1,19 @@
+package models
+
+// Ad is a struct that represents an ad (a promoted pin)
+// from the Pinterest v5 API.
+type Ad struct {
+	Id             string `json:"id"`
+	AdAccountId    string `json:"ad_account_id"`
+	CampaignId     string `json:"campaign_id"`
+	AdGroupId      string `json:"ad_group_id"`
+	PinId          string `json:"pin_id"`
+	Name           string `json:"name"`
+	Status         string `json:"status"`
+	CreativeType   string `json:"creative_type"`
+	DestinationUrl string `json:"destination_url"`
+	ReviewStatus   string `json:"review_status"`
+	CreatedTime    int64  `json:"created_time"`
+	UpdatedTime    int64  `json:"updated_time"`
+	Raw            Raw    `json:"-"`
+}
@@ -0,0 +1,27 @@
+package models
+
+// AdAccount is a struct that represents an ad account
+// from the Pinterest v5 API.
+type AdAccount struct {
+	Id          string         `json:"id"`
+	Name        string         `json:"name"`
+	Owner       AdAccountOwner `json:"owner"`
+	Country     string         `json:"country"`
+	Currency    string         `json:"currency"`
+	Permissions []string       `json:"permissions"`
+	CreatedTime int64          `json:"created_time"`
+	UpdatedTime int64          `json:"updated_time"`
+	Raw         Raw            `json:"-"`
+}
+
+// Money returns amount in the ad account's currency.
+func (m *AdAccount) Money(amount MicroCurrency) Money {
+	return Money{Amount: amount, Currency: m.Currency}
+}
+
+// AdAccountOwner is a struct that represents the owner of an ad account.
+type AdAccountOwner struct {
+	Id       string `json:"id"`
+	Username string `json:"username"`
+	Raw      Raw    `json:"-"`
+}
@@ -0,0 +1,24 @@
+package models
+
+// AdGroup is a struct that represents an ad group
+// from the Pinterest v5 API.
+//
+// Budgets and bids are nil when they are not set on the ad group.
+type AdGroup struct {
+	Id                    string              `json:"id"`
+	AdAccountId           string              `json:"ad_account_id"`
+	CampaignId            string              `json:"campaign_id"`
+	Name                  string              `json:"name"`
+	Status                string              `json:"status"`
+	BudgetInMicroCurrency *MicroCurrency      `json:"budget_in_micro_currency"`
+	BidInMicroCurrency    *MicroCurrency      `json:"bid_in_micro_currency"`
+	BudgetType            string              `json:"budget_type"`
+	BillableEvent         string              `json:"billable_event"`
+	PacingDeliveryType    string              `json:"pacing_delivery_type"`
+	TargetingSpec         map[string][]string `json:"targeting_spec"`
+	StartTime             int64               `json:"start_time"`
+	EndTime               int64               `json:"end_time"`
+	CreatedTime           int64               `json:"created_time"`
+	UpdatedTime           int64               `json:"updated_time"`
+	Raw                   Raw                 `json:"-"`
+}
@@ -0,0 +1,30 @@
+package models
+
+// Analytics is a struct that represents the metrics of a user account
+// or pin over a date range, as a series of daily metrics along with their
+// totals.
+type Analytics struct {
+	DailyMetrics   []DailyMetrics     `json:"daily_metrics"`
+	SummaryMetrics map[string]float64 `json:"summary_metrics"`
+	Raw            Raw                `json:"-"`
+}
+
+// Series returns the daily values of a metric, in date order.
+// Days without a value for the metric are returned as zero.
+func (m *Analytics) Series(metric string) []float64 {
+	series := make([]float64, len(m.DailyMetrics))
+	for i, daily := range m.DailyMetrics {
+		series[i] = daily.Metrics[metric]
+	}
+	return series
+}
+
+// DailyMetrics is a struct that represents the metrics of a single day.
+//
+// DataStatus is READY once the day's metrics are final.
+type DailyMetrics struct {
+	Date       Date               `json:"date"`
+	DataStatus string             `json:"data_status"`
+	Metrics    map[string]float64 `json:"metrics"`
+	Raw        Raw                `json:"-"`
+}
@@ -0,0 +1,35 @@
+package models
+
+// The types of audiences.
+const (
+	AUDIENCE_TYPE_CUSTOMER_LIST = "CUSTOMER_LIST"
+	AUDIENCE_TYPE_VISITOR       = "VISITOR"
+	AUDIENCE_TYPE_ENGAGEMENT    = "ENGAGEMENT"
+	AUDIENCE_TYPE_ACTALIKE      = "ACTALIKE"
+)
+
+// Audience is a struct that represents a group of users that ads can
+// target, such as the users of a customer list.
+type Audience struct {
+	Id               string       `json:"id"`
+	AdAccountId      string       `json:"ad_account_id"`
+	Name             string       `json:"name"`
+	AudienceType     string       `json:"audience_type"`
+	Description      string       `json:"description"`
+	Rule             AudienceRule `json:"rule"`
+	Size             int64        `json:"size"`
+	Status           string       `json:"status"`
+	CreatedTimestamp int64        `json:"created_timestamp"`
+	UpdatedTimestamp int64        `json:"updated_timestamp"`
+	Raw              Raw          `json:"-"`
+}
+
+// AudienceRule is a struct that represents which users are in an
+// audience.  Which fields apply depends on the audience's type.
+type AudienceRule struct {
+	CustomerListId string `json:"customer_list_id,omitempty"`
+	Country        string `json:"country,omitempty"`
+	RetentionDays  int    `json:"retention_days,omitempty"`
+	Percentage     int    `json:"percentage,omitempty"`
+	Raw            Raw    `json:"-"`
+}
@@ -0,0 +1,23 @@
+package models
+
+import "github.com/BrandonRomano/iso8601"
+
+// Board is a struct that represents an individual board
+// from the Pinterest v5 API.
+type Board struct {
+	Id                string       `json:"id"`
+	Name              string       `json:"name"`
+	Description       string       `json:"description"`
+	Owner             BoardOwner   `json:"owner"`
+	Privacy           string       `json:"privacy"`
+	CreatedAt         iso8601.Time `json:"created_at"`
+	PinCount          int32        `json:"pin_count"`
+	FollowerCount     int32        `json:"follower_count"`
+	CollaboratorCount int32        `json:"collaborator_count"`
+	Raw               Raw          `json:"-"`
+}
+
+type BoardOwner struct {
+	Username string `json:"username"`
+	Raw      Raw    `json:"-"`
+}
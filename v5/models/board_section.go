@@ -0,0 +1,9 @@
+package models
+
+// BoardSection is a struct that represents an individual section
+// of a board from the Pinterest v5 API.
+type BoardSection struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Raw  Raw    `json:"-"`
+}
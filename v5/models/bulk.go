@@ -0,0 +1,47 @@
+package models
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// The statuses of ads API entities (campaigns, ad groups and ads).
+const (
+	ENTITY_STATUS_ACTIVE   = "ACTIVE"
+	ENTITY_STATUS_PAUSED   = "PAUSED"
+	ENTITY_STATUS_ARCHIVED = "ARCHIVED"
+)
+
+// BulkItem is a struct that represents the outcome of one of the entities
+// in an ads API create or update request, which operate on lists.
+type BulkItem struct {
+	Data       json.RawMessage `json:"data"`
+	Exceptions []BulkException `json:"exceptions"`
+}
+
+// BulkException is a struct that represents why an
+// entity could not be created or updated.
+type BulkException struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// DecodeBulkItem decodes the only entity of a bulk response into v, or
+// returns a PinterestError if the entity could not be created or updated.
+func DecodeBulkItem(httpResponse *http.Response, items []BulkItem, v interface{}) error {
+	if len(items) == 0 {
+		return &PinterestError{
+			StatusCode: httpResponse.StatusCode,
+			Message:    "the response did not contain any items",
+		}
+	}
+	if len(items[0].Exceptions) > 0 {
+		exception := items[0].Exceptions[0]
+		return &PinterestError{
+			StatusCode: httpResponse.StatusCode,
+			Code:       exception.Code,
+			Message:    exception.Message,
+		}
+	}
+	return json.Unmarshal(items[0].Data, v)
+}
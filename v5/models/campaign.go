@@ -0,0 +1,21 @@
+package models
+
+// Campaign is a struct that represents an ad campaign
+// from the Pinterest v5 API.
+//
+// Spend caps are nil when the campaign has no cap.
+type Campaign struct {
+	Id               string         `json:"id"`
+	AdAccountId      string         `json:"ad_account_id"`
+	Name             string         `json:"name"`
+	Status           string         `json:"status"`
+	ObjectiveType    string         `json:"objective_type"`
+	LifetimeSpendCap *MicroCurrency `json:"lifetime_spend_cap"`
+	DailySpendCap    *MicroCurrency `json:"daily_spend_cap"`
+	OrderLineId      string         `json:"order_line_id"`
+	StartTime        int64          `json:"start_time"`
+	EndTime          int64          `json:"end_time"`
+	CreatedTime      int64          `json:"created_time"`
+	UpdatedTime      int64          `json:"updated_time"`
+	Raw              Raw            `json:"-"`
+}
@@ -0,0 +1,49 @@
+package models
+
+import "github.com/BrandonRomano/iso8601"
+
+// CatalogFeed is a struct that represents a product feed: a file of
+// products that Pinterest fetches from Location and ingests into a
+// catalog.
+type CatalogFeed struct {
+	Id                  string       `json:"id"`
+	Name                string       `json:"name"`
+	Format              string       `json:"format"`
+	Location            string       `json:"location"`
+	Status              string       `json:"status"`
+	DefaultCurrency     string       `json:"default_currency"`
+	DefaultLocale       string       `json:"default_locale"`
+	DefaultAvailability string       `json:"default_availability"`
+	CreatedAt           iso8601.Time `json:"created_at"`
+	UpdatedAt           iso8601.Time `json:"updated_at"`
+	Raw                 Raw          `json:"-"`
+}
+
+// FeedProcessingResult is a struct that represents the outcome
+// of Pinterest ingesting a product feed.
+type FeedProcessingResult struct {
+	Id                string                `json:"id"`
+	Status            string                `json:"status"`
+	ProductCounts     FeedProductCounts     `json:"product_counts"`
+	IngestionDetails  FeedValidationDetails `json:"ingestion_details"`
+	ValidationDetails FeedValidationDetails `json:"validation_details"`
+	CreatedAt         iso8601.Time          `json:"created_at"`
+	UpdatedAt         iso8601.Time          `json:"updated_at"`
+	Raw               Raw                   `json:"-"`
+}
+
+// FeedProductCounts is a struct that represents how many of the
+// products in a feed were ingested.
+type FeedProductCounts struct {
+	Original int64 `json:"original"`
+	Ingested int64 `json:"ingested"`
+	Raw      Raw   `json:"-"`
+}
+
+// FeedValidationDetails is a struct that represents how many products
+// in a feed had each kind of error or warning.
+type FeedValidationDetails struct {
+	Errors   map[string]int64 `json:"errors"`
+	Warnings map[string]int64 `json:"warnings"`
+	Raw      Raw              `json:"-"`
+}
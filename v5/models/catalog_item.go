@@ -0,0 +1,64 @@
+package models
+
+// MAX_ITEMS_PER_BATCH is the most catalog items that can be
+// sent in a single batch request.
+const MAX_ITEMS_PER_BATCH = 1000
+
+// The statuses of a batch of catalog items, and of each item in it.
+const (
+	ITEMS_BATCH_STATUS_PROCESSING = "PROCESSING"
+	ITEMS_BATCH_STATUS_COMPLETED  = "COMPLETED"
+	ITEMS_BATCH_STATUS_FAILED     = "FAILED"
+
+	ITEM_STATUS_SUCCESS = "SUCCESS"
+	ITEM_STATUS_FAILURE = "FAILURE"
+)
+
+// CatalogItem is a struct that represents a product to upsert into a
+// catalog, with its attributes (such as title, link and price) keyed
+// by their API name.
+type CatalogItem struct {
+	ItemId     string                 `json:"item_id"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// ItemsBatch is a struct that represents a batch of catalog items
+// being processed, and the outcome of each item once it has been.
+type ItemsBatch struct {
+	BatchId       string                 `json:"batch_id"`
+	Status        string                 `json:"status"`
+	CreatedTime   string                 `json:"created_time"`
+	CompletedTime string                 `json:"completed_time"`
+	Items         []ItemProcessingRecord `json:"items"`
+	Raw           Raw                    `json:"-"`
+}
+
+// Failures returns the items of the batch that could not be processed.
+func (m *ItemsBatch) Failures() []ItemProcessingRecord {
+	failures := []ItemProcessingRecord{}
+	for _, item := range m.Items {
+		if item.Status == ITEM_STATUS_FAILURE || len(item.Errors) > 0 {
+			failures = append(failures, item)
+		}
+	}
+	return failures
+}
+
+// ItemProcessingRecord is a struct that represents the outcome
+// of a single item in a batch.
+type ItemProcessingRecord struct {
+	ItemId   string                `json:"item_id"`
+	Status   string                `json:"status"`
+	Errors   []ItemValidationEvent `json:"errors"`
+	Warnings []ItemValidationEvent `json:"warnings"`
+	Raw      Raw                   `json:"-"`
+}
+
+// ItemValidationEvent is a struct that represents an error or warning
+// about one of an item's attributes.
+type ItemValidationEvent struct {
+	Attribute string `json:"attribute"`
+	Code      int    `json:"code"`
+	Message   string `json:"message"`
+	Raw       Raw    `json:"-"`
+}
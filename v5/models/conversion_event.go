@@ -0,0 +1,201 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// MAX_CONVERSION_EVENTS_PER_REQUEST is the most conversion events
+// that can be sent in a single request.
+const MAX_CONVERSION_EVENTS_PER_REQUEST = 1000
+
+// MAX_CONVERSION_EVENT_AGE is how old a conversion event can be
+// when it is sent.
+const MAX_CONVERSION_EVENT_AGE = 7 * 24 * time.Hour
+
+// The names of the standard conversion events.
+const (
+	CONVERSION_EVENT_ADD_TO_CART   = "add_to_cart"
+	CONVERSION_EVENT_CHECKOUT      = "checkout"
+	CONVERSION_EVENT_CUSTOM        = "custom"
+	CONVERSION_EVENT_LEAD          = "lead"
+	CONVERSION_EVENT_PAGE_VISIT    = "page_visit"
+	CONVERSION_EVENT_SEARCH        = "search"
+	CONVERSION_EVENT_SIGNUP        = "signup"
+	CONVERSION_EVENT_VIEW_CATEGORY = "view_category"
+	CONVERSION_EVENT_WATCH_VIDEO   = "watch_video"
+)
+
+// The sources a conversion event can come from.
+const (
+	ACTION_SOURCE_APP_ANDROID = "app_android"
+	ACTION_SOURCE_APP_IOS     = "app_ios"
+	ACTION_SOURCE_WEB         = "web"
+	ACTION_SOURCE_OFFLINE     = "offline"
+)
+
+// ConversionEvent is a struct that represents a conversion event sent to
+// the Conversions API, such as a checkout on your website.
+//
+// EventId identifies the event, so Pinterest can deduplicate it against
+// the same event sent by the Pinterest tag.
+type ConversionEvent struct {
+	EventName      string                `json:"event_name"`
+	ActionSource   string                `json:"action_source"`
+	EventTime      int64                 `json:"event_time"`
+	EventId        string                `json:"event_id"`
+	EventSourceUrl string                `json:"event_source_url,omitempty"`
+	OptOut         bool                  `json:"opt_out,omitempty"`
+	PartnerName    string                `json:"partner_name,omitempty"`
+	UserData       ConversionUserData    `json:"user_data"`
+	CustomData     *ConversionCustomData `json:"custom_data,omitempty"`
+}
+
+// ConversionUserData is a struct that represents who a conversion event
+// is about.
+//
+// Personal data (emails, phones, names, and the like) may be set in plain
+// text: it is normalized and hashed with SHA-256 by Hashed before it is
+// sent.  Values that are already hashed are sent as they are.
+type ConversionUserData struct {
+	Emails          []string `json:"em,omitempty"`
+	Phones          []string `json:"ph,omitempty"`
+	FirstNames      []string `json:"fn,omitempty"`
+	LastNames       []string `json:"ln,omitempty"`
+	Genders         []string `json:"ge,omitempty"`
+	DatesOfBirth    []string `json:"db,omitempty"`
+	Cities          []string `json:"ct,omitempty"`
+	States          []string `json:"st,omitempty"`
+	ZipCodes        []string `json:"zp,omitempty"`
+	Countries       []string `json:"country,omitempty"`
+	ExternalIds     []string `json:"external_id,omitempty"`
+	MAIDs           []string `json:"hashed_maids,omitempty"`
+	ClientIpAddress string   `json:"client_ip_address,omitempty"`
+	ClientUserAgent string   `json:"client_user_agent,omitempty"`
+}
+
+// ConversionCustomData is a struct that represents the details of a
+// conversion event, such as the value of a checkout.
+//
+// Value is a decimal amount in Currency, such as "12.50".
+type ConversionCustomData struct {
+	Currency        string              `json:"currency,omitempty"`
+	Value           string              `json:"value,omitempty"`
+	ContentIds      []string            `json:"content_ids,omitempty"`
+	ContentName     string              `json:"content_name,omitempty"`
+	ContentCategory string              `json:"content_category,omitempty"`
+	ContentBrand    string              `json:"content_brand,omitempty"`
+	Contents        []ConversionContent `json:"contents,omitempty"`
+	NumItems        int64               `json:"num_items,omitempty"`
+	OrderId         string              `json:"order_id,omitempty"`
+	SearchString    string              `json:"search_string,omitempty"`
+}
+
+// ConversionContent is a struct that represents a product
+// in a conversion event.
+type ConversionContent struct {
+	Id        string `json:"id,omitempty"`
+	ItemPrice string `json:"item_price,omitempty"`
+	Quantity  int64  `json:"quantity,omitempty"`
+}
+
+// Validate returns an error if the event would be rejected by the
+// Conversions API, so it can be reported before the event is sent.
+func (e *ConversionEvent) Validate() error {
+	switch e.EventName {
+	case CONVERSION_EVENT_ADD_TO_CART, CONVERSION_EVENT_CHECKOUT, CONVERSION_EVENT_CUSTOM,
+		CONVERSION_EVENT_LEAD, CONVERSION_EVENT_PAGE_VISIT, CONVERSION_EVENT_SEARCH,
+		CONVERSION_EVENT_SIGNUP, CONVERSION_EVENT_VIEW_CATEGORY, CONVERSION_EVENT_WATCH_VIDEO:
+	default:
+		return fmt.Errorf("invalid conversion event name %q", e.EventName)
+	}
+	switch e.ActionSource {
+	case ACTION_SOURCE_APP_ANDROID, ACTION_SOURCE_APP_IOS, ACTION_SOURCE_WEB, ACTION_SOURCE_OFFLINE:
+	default:
+		return fmt.Errorf("invalid conversion event action source %q", e.ActionSource)
+	}
+	if e.EventId == "" {
+		return fmt.Errorf("conversion event is missing its event id")
+	}
+
+	// Events can't be from the future, or too old
+	eventTime := time.Unix(e.EventTime, 0)
+	if e.EventTime <= 0 || eventTime.After(time.Now().Add(time.Minute)) {
+		return fmt.Errorf("invalid conversion event time %d", e.EventTime)
+	}
+	if time.Since(eventTime) > MAX_CONVERSION_EVENT_AGE {
+		return fmt.Errorf("conversion event %s is older than %s", e.EventId, MAX_CONVERSION_EVENT_AGE)
+	}
+
+	// Pinterest needs to be able to match the event to a user
+	userData := e.UserData
+	if len(userData.Emails) == 0 && len(userData.MAIDs) == 0 &&
+		(userData.ClientIpAddress == "" || userData.ClientUserAgent == "") {
+		return fmt.Errorf("conversion event %s needs an email, a MAID, or a client ip address and user agent", e.EventId)
+	}
+
+	if e.CustomData != nil && e.CustomData.Value != "" {
+		if _, err := strconv.ParseFloat(e.CustomData.Value, 64); err != nil {
+			return fmt.Errorf("conversion event %s has an invalid value %q", e.EventId, e.CustomData.Value)
+		}
+		if e.CustomData.Currency == "" {
+			return fmt.Errorf("conversion event %s has a value, but no currency", e.EventId)
+		}
+	}
+	return nil
+}
+
+// Hashed returns a copy of the user data with its personal
+// data normalized and hashed.
+func (u ConversionUserData) Hashed() ConversionUserData {
+	u.Emails = hashAll(u.Emails, NormalizeEmail)
+	u.Phones = hashAll(u.Phones, NormalizePhone)
+	u.FirstNames = hashAll(u.FirstNames, NormalizeName)
+	u.LastNames = hashAll(u.LastNames, NormalizeName)
+	u.Genders = hashAll(u.Genders, NormalizeName)
+	u.DatesOfBirth = hashAll(u.DatesOfBirth, NormalizeCompact)
+	u.Cities = hashAll(u.Cities, NormalizeCompact)
+	u.States = hashAll(u.States, NormalizeCompact)
+	u.ZipCodes = hashAll(u.ZipCodes, NormalizeCompact)
+	u.Countries = hashAll(u.Countries, NormalizeCompact)
+	u.ExternalIds = hashAll(u.ExternalIds, func(s string) string { return s })
+	u.MAIDs = hashAll(u.MAIDs, NormalizeMAID)
+	return u
+}
+
+// hashAll returns a copy of values, normalized and hashed.
+func hashAll(values []string, normalize func(string) string) []string {
+	if values == nil {
+		return nil
+	}
+	hashed := make([]string, len(values))
+	for i, value := range values {
+		hashed[i] = HashNormalized(value, normalize)
+	}
+	return hashed
+}
+
+// ConversionEventsResult is a struct that represents how the Conversions
+// API processed a request's events.  Events is in the same order as the
+// events that were sent.
+type ConversionEventsResult struct {
+	NumEventsReceived  int64                   `json:"num_events_received"`
+	NumEventsProcessed int64                   `json:"num_events_processed"`
+	Events             []ConversionEventStatus `json:"events"`
+	Raw                Raw                     `json:"-"`
+}
+
+// ConversionEventStatus is a struct that represents how
+// the Conversions API processed a single event.
+type ConversionEventStatus struct {
+	Status         string `json:"status"`
+	ErrorMessage   string `json:"error_message"`
+	WarningMessage string `json:"warning_message"`
+	Raw            Raw    `json:"-"`
+}
+
+// Failed returns true if the event was not processed.
+func (m *ConversionEventStatus) Failed() bool {
+	return m.Status == "failed"
+}
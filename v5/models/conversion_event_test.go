@@ -0,0 +1,82 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/carrot/go-pinterest/v5/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// validEvent returns a conversion event that passes validation.
+func validEvent() models.ConversionEvent {
+	return models.ConversionEvent{
+		EventName:    models.CONVERSION_EVENT_CHECKOUT,
+		ActionSource: models.ACTION_SOURCE_WEB,
+		EventTime:    time.Now().Unix(),
+		EventId:      "1",
+		UserData:     models.ConversionUserData{Emails: []string{"jane@example.com"}},
+		CustomData:   &models.ConversionCustomData{Currency: "USD", Value: "12.50"},
+	}
+}
+
+// TestConversionEventValidate tests that events the Conversions API
+// would reject are reported before they are sent.
+func TestConversionEventValidate(t *testing.T) {
+	assert.Nil(t, (&models.ConversionEvent{
+		EventName:    models.CONVERSION_EVENT_PAGE_VISIT,
+		ActionSource: models.ACTION_SOURCE_APP_IOS,
+		EventTime:    time.Now().Unix(),
+		EventId:      "2",
+		UserData: models.ConversionUserData{
+			ClientIpAddress: "203.0.113.1",
+			ClientUserAgent: "Mozilla/5.0",
+		},
+	}).Validate())
+
+	invalid := map[string]func(e *models.ConversionEvent){
+		"unknown event name":  func(e *models.ConversionEvent) { e.EventName = "purchase" },
+		"unknown source":      func(e *models.ConversionEvent) { e.ActionSource = "email" },
+		"missing event id":    func(e *models.ConversionEvent) { e.EventId = "" },
+		"missing event time":  func(e *models.ConversionEvent) { e.EventTime = 0 },
+		"event in the future": func(e *models.ConversionEvent) { e.EventTime = time.Now().Add(time.Hour).Unix() },
+		"event too old":       func(e *models.ConversionEvent) { e.EventTime = time.Now().Add(-8 * 24 * time.Hour).Unix() },
+		"no user to match": func(e *models.ConversionEvent) {
+			e.UserData = models.ConversionUserData{ClientIpAddress: "203.0.113.1"}
+		},
+		"value isn't a number":   func(e *models.ConversionEvent) { e.CustomData.Value = "12,50" },
+		"value without currency": func(e *models.ConversionEvent) { e.CustomData.Currency = "" },
+	}
+	for name, invalidate := range invalid {
+		event := validEvent()
+		assert.Nil(t, event.Validate(), name)
+		invalidate(&event)
+		assert.NotNil(t, event.Validate(), name)
+	}
+}
+
+// TestConversionUserDataHashed tests that user data is normalized
+// and hashed, leaving values that are already hashed as they are.
+func TestConversionUserDataHashed(t *testing.T) {
+	alreadyHashed := models.HashSHA256("someone@example.com")
+	userData := models.ConversionUserData{
+		Emails:      []string{" Jane@Example.com", alreadyHashed},
+		Phones:      []string{"+1 (555) 010-0000"},
+		FirstNames:  []string{" Jane "},
+		Cities:      []string{"San Francisco"},
+		ExternalIds: []string{"Customer-1"},
+		MAIDs:       []string{"AEBE52E7-03EE-455A-B3C4-E57283966239"},
+	}
+
+	hashed := userData.Hashed()
+	assert.Equal(t, []string{models.HashSHA256("jane@example.com"), alreadyHashed}, hashed.Emails)
+	assert.Equal(t, []string{models.HashSHA256("15550100000")}, hashed.Phones)
+	assert.Equal(t, []string{models.HashSHA256("jane")}, hashed.FirstNames)
+	assert.Equal(t, []string{models.HashSHA256("sanfrancisco")}, hashed.Cities)
+	assert.Equal(t, []string{models.HashSHA256("Customer-1")}, hashed.ExternalIds)
+	assert.Equal(t, []string{models.HashSHA256("aebe52e7-03ee-455a-b3c4-e57283966239")}, hashed.MAIDs)
+	assert.Nil(t, hashed.LastNames)
+
+	// The user data is copied, not hashed in place
+	assert.Equal(t, " Jane@Example.com", userData.Emails[0])
+}
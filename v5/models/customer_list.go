@@ -0,0 +1,69 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The types of records a customer list can hold.
+const (
+	CUSTOMER_LIST_TYPE_EMAIL = "EMAIL"
+	CUSTOMER_LIST_TYPE_MAID  = "MAID"
+)
+
+// CustomerList is a struct that represents a list of hashed customer
+// identifiers (such as emails), which audiences can be built on.
+type CustomerList struct {
+	Id                     string `json:"id"`
+	AdAccountId            string `json:"ad_account_id"`
+	Name                   string `json:"name"`
+	Status                 string `json:"status"`
+	Type                   string `json:"type"`
+	NumBatches             int64  `json:"num_batches"`
+	NumUploadedUserRecords int64  `json:"num_uploaded_user_records"`
+	NumRemovedUserRecords  int64  `json:"num_removed_user_records"`
+	CreatedTime            int64  `json:"created_time"`
+	UpdatedTime            int64  `json:"updated_time"`
+	Raw                    Raw    `json:"-"`
+}
+
+// NormalizeCustomerListRecord normalizes and hashes a record of a
+// customer list of the specified type.  Records that are already
+// SHA-256 hashes are left as they are.
+func NormalizeCustomerListRecord(listType string, record string) string {
+	switch listType {
+	case CUSTOMER_LIST_TYPE_EMAIL:
+		return HashNormalized(record, NormalizeEmail)
+	case CUSTOMER_LIST_TYPE_MAID:
+		return HashNormalized(record, NormalizeMAID)
+	}
+	return HashNormalized(record, func(s string) string { return s })
+}
+
+// MAX_CUSTOMER_LIST_RECORDS_PER_REQUEST is how many records of a customer
+// list are sent per request by default, which keeps requests well within
+// the API's size limit.
+const MAX_CUSTOMER_LIST_RECORDS_PER_REQUEST = 50000
+
+// ErrNoCustomerListRecords is returned when a customer list is
+// created, appended to or removed from with no records.
+var ErrNoCustomerListRecords = errors.New("no customer list records to upload")
+
+// PartialUploadError is the error returned when only some of the
+// records of a customer list could be uploaded.  The first Uploaded
+// records were uploaded, and the rest can be retried.
+type PartialUploadError struct {
+	Uploaded int
+	Total    int
+	Err      error
+}
+
+// Error returns the error message of a PartialUploadError.
+func (e *PartialUploadError) Error() string {
+	return fmt.Sprintf("uploaded %d of %d records: %v", e.Uploaded, e.Total, e.Err)
+}
+
+// Unwrap returns the error that stopped the upload.
+func (e *PartialUploadError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DATE_FORMAT is the layout of the dates that the v5 API
+// accepts and returns, such as 2021-03-31.
+const DATE_FORMAT = "2006-01-02"
+
+// Date is a calendar date (without a time of day), as used by the
+// analytics endpoints.
+type Date struct {
+	time.Time
+}
+
+// ParseDate parses a date in the form 2021-03-31.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(DATE_FORMAT, s)
+	return Date{t}, err
+}
+
+// String formats the date in the form 2021-03-31.
+func (d Date) String() string {
+	return d.Format(DATE_FORMAT)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
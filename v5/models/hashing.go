@@ -0,0 +1,75 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode"
+)
+
+// HashSHA256 returns the hex encoded SHA-256 hash of value, which is
+// how Pinterest expects personal data (such as emails) to be sent.
+func HashSHA256(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsSHA256 returns true if value is already a hex encoded SHA-256 hash.
+func IsSHA256(value string) bool {
+	if len(value) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(value)
+	return err == nil
+}
+
+// NormalizeEmail normalizes an email address before it is hashed,
+// by trimming surrounding whitespace and lowercasing it.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NormalizeMAID normalizes a mobile advertising id (an IDFA or AAID)
+// before it is hashed, by trimming surrounding whitespace and
+// lowercasing it.
+func NormalizeMAID(maid string) string {
+	return strings.ToLower(strings.TrimSpace(maid))
+}
+
+// NormalizePhone normalizes a phone number before it is hashed, by
+// keeping only its digits.  The number should include its country code.
+func NormalizePhone(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, phone)
+}
+
+// HashNormalized normalizes value and hashes it, unless value
+// is already a SHA-256 hash, which is returned lowercased.
+func HashNormalized(value string, normalize func(string) string) string {
+	trimmed := strings.TrimSpace(value)
+	if IsSHA256(trimmed) {
+		return strings.ToLower(trimmed)
+	}
+	return HashSHA256(normalize(trimmed))
+}
+
+// NormalizeName normalizes a name (or gender) before it is hashed,
+// by trimming surrounding whitespace and lowercasing it.
+func NormalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// NormalizeCompact normalizes a location (such as a city or zip code)
+// before it is hashed, by lowercasing it and removing all whitespace.
+func NormalizeCompact(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, strings.ToLower(value))
+}
@@ -0,0 +1,40 @@
+package models_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/carrot/go-pinterest/v5/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHashSHA256 tests that values are hashed as Pinterest expects,
+// and that hashes are recognized.
+func TestHashSHA256(t *testing.T) {
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", models.HashSHA256(""))
+	hash := models.HashSHA256("someone@example.com")
+	assert.True(t, models.IsSHA256(hash))
+	assert.True(t, models.IsSHA256(strings.ToUpper(hash)))
+	assert.False(t, models.IsSHA256("someone@example.com"))
+	assert.False(t, models.IsSHA256(strings.Repeat("z", 64)))
+}
+
+// TestHashNormalized tests that values are normalized before they
+// are hashed, unless they are already hashed.
+func TestHashNormalized(t *testing.T) {
+	hash := models.HashSHA256("someone@example.com")
+	assert.Equal(t, hash, models.HashNormalized(" Someone@Example.com ", models.NormalizeEmail))
+	assert.Equal(t, hash, models.HashNormalized(" "+strings.ToUpper(hash), models.NormalizeEmail))
+
+	assert.Equal(t, "15550100000", models.NormalizePhone("+1 (555) 010-0000"))
+	assert.Equal(t, "newyork", models.NormalizeCompact(" New York "))
+	assert.Equal(t, "jane", models.NormalizeName(" Jane "))
+	assert.Equal(t, "aebe52e7", models.NormalizeMAID(" AEBE52E7 "))
+}
+
+// TestNormalizeCustomerListRecord tests that customer list records
+// are normalized by the type of their list.
+func TestNormalizeCustomerListRecord(t *testing.T) {
+	assert.Equal(t, models.HashSHA256("someone@example.com"), models.NormalizeCustomerListRecord(models.CUSTOMER_LIST_TYPE_EMAIL, " Someone@Example.com"))
+	assert.Equal(t, models.HashSHA256("aebe52e7"), models.NormalizeCustomerListRecord(models.CUSTOMER_LIST_TYPE_MAID, "AEBE52E7"))
+}
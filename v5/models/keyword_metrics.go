@@ -0,0 +1,25 @@
+package models
+
+// KeywordMetrics is a struct that represents the search
+// metrics of a keyword in a country.
+type KeywordMetrics struct {
+	Keyword string              `json:"keyword"`
+	Metrics KeywordMetricValues `json:"metrics"`
+	Raw     Raw                 `json:"-"`
+}
+
+// KeywordMetricValues is a struct that represents the metrics of a
+// keyword.  Competition is one of LOW, MEDIUM or HIGH.
+type KeywordMetricValues struct {
+	AvgCpcInMicroCurrency float64 `json:"avg_cpc_in_micro_currency"`
+	Competition           string  `json:"competition"`
+	MonthlySearches       int64   `json:"monthly_searches"`
+	Raw                   Raw     `json:"-"`
+}
+
+// KeywordMetricsList is a struct that represents the metrics
+// of the keywords that were requested.
+type KeywordMetricsList struct {
+	All []KeywordMetrics `json:"all"`
+	Raw Raw              `json:"-"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import "fmt"
+
+// The statuses that uploaded media goes through while Pinterest
+// processes it.
+const (
+	MEDIA_STATUS_REGISTERED = "registered"
+	MEDIA_STATUS_PROCESSING = "processing"
+	MEDIA_STATUS_SUCCEEDED  = "succeeded"
+	MEDIA_STATUS_FAILED     = "failed"
+)
+
+// MediaUpload is a struct that represents registered media, and where
+// its file should be uploaded to.
+type MediaUpload struct {
+	MediaId          string            `json:"media_id"`
+	MediaType        string            `json:"media_type"`
+	UploadUrl        string            `json:"upload_url"`
+	UploadParameters map[string]string `json:"upload_parameters"`
+	Raw              Raw               `json:"-"`
+}
+
+// Media is a struct that represents the processing status
+// of uploaded media.
+type Media struct {
+	MediaId   string `json:"media_id"`
+	MediaType string `json:"media_type"`
+	Status    string `json:"status"`
+	Raw       Raw    `json:"-"`
+}
+
+// MediaProcessingError is the error returned when Pinterest
+// failed to process uploaded media.
+type MediaProcessingError struct {
+	MediaId string
+	Status  string
+}
+
+// Error returns the error message of a MediaProcessingError.
+func (e *MediaProcessingError) Error() string {
+	return fmt.Sprintf("Pinterest failed to process media %s (status: %s)", e.MediaId, e.Status)
+}
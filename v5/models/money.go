@@ -0,0 +1,90 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MICROS_PER_UNIT is the number of micro currency units in one unit
+// of a currency (such as one US dollar).
+const MICROS_PER_UNIT = 1000000
+
+// MicroCurrency is an amount of money in millionths of a currency unit,
+// which is how the ads API represents budgets, spend caps and bids.
+// The currency is the Currency of the ad account.
+//
+// Amounts are kept as integers so they are never subject to
+// floating point rounding.
+type MicroCurrency int64
+
+// ParseMicroCurrency parses a decimal amount of currency units
+// (such as "12.50") into a MicroCurrency.
+func ParseMicroCurrency(s string) (MicroCurrency, error) {
+	trimmed := strings.TrimSpace(s)
+	negative := strings.HasPrefix(trimmed, "-")
+	trimmed = strings.TrimPrefix(trimmed, "-")
+	units, fraction := trimmed, ""
+	if i := strings.Index(trimmed, "."); i >= 0 {
+		units, fraction = trimmed[:i], trimmed[i+1:]
+	}
+	if (units == "" && fraction == "") || !isDigits(units) || !isDigits(fraction) {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	if len(fraction) > 6 {
+		return 0, fmt.Errorf("invalid amount %q: more precise than micro currency", s)
+	}
+
+	// Combine the units and the fraction, padded to millionths
+	micros, err := strconv.ParseInt(units+fraction+strings.Repeat("0", 6-len(fraction)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q: %v", s, err)
+	}
+	if negative {
+		micros = -micros
+	}
+	return MicroCurrency(micros), nil
+}
+
+// isDigits returns true if s only contains the digits 0-9.
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Units returns a MicroCurrency of whole currency units.
+func Units(units int64) MicroCurrency {
+	return MicroCurrency(units * MICROS_PER_UNIT)
+}
+
+// String formats the amount in currency units, with at least two
+// decimal places (such as "12.50").
+func (m MicroCurrency) String() string {
+	amount := int64(m)
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	fraction := strings.TrimRight(fmt.Sprintf("%06d", amount%MICROS_PER_UNIT), "0")
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+	return fmt.Sprintf("%s%d.%s", sign, amount/MICROS_PER_UNIT, fraction)
+}
+
+// Money is an amount of money along with its currency,
+// for displaying amounts from an ad account.
+type Money struct {
+	Amount   MicroCurrency
+	Currency string
+}
+
+// String formats the amount followed by its currency (such as "12.50 USD").
+func (m Money) String() string {
+	return m.Amount.String() + " " + m.Currency
+}
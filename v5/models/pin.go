@@ -0,0 +1,65 @@
+package models
+
+import "github.com/BrandonRomano/iso8601"
+
+// Pin is a struct that represents an individual pin
+// from the Pinterest v5 API.
+type Pin struct {
+	Id             string       `json:"id"`
+	CreatedAt      iso8601.Time `json:"created_at"`
+	Link           string       `json:"link"`
+	Title          string       `json:"title"`
+	Description    string       `json:"description"`
+	AltText        string       `json:"alt_text"`
+	Note           string       `json:"note"`
+	DominantColor  string       `json:"dominant_color"`
+	BoardId        string       `json:"board_id"`
+	BoardSectionId string       `json:"board_section_id"`
+	BoardOwner     BoardOwner   `json:"board_owner"`
+	ParentPinId    string       `json:"parent_pin_id"`
+	Media          PinMedia     `json:"media"`
+	Raw            Raw          `json:"-"`
+}
+
+// PinMedia is the media of a pin.  Images is keyed by
+// size, such as "150x150" or "originals".
+type PinMedia struct {
+	MediaType string              `json:"media_type"`
+	Images    map[string]PinImage `json:"images"`
+	Raw       Raw                 `json:"-"`
+}
+
+type PinImage struct {
+	Url    string `json:"url"`
+	Width  int32  `json:"width"`
+	Height int32  `json:"height"`
+	Raw    Raw    `json:"-"`
+}
+
+// PinMediaSource is a struct that represents the media a pin is created
+// from.  Use ImageURLSource, ImageBase64Source or VideoSource to build one.
+type PinMediaSource struct {
+	SourceType    string `json:"source_type"`
+	Url           string `json:"url,omitempty"`
+	ContentType   string `json:"content_type,omitempty"`
+	Data          string `json:"data,omitempty"`
+	MediaId       string `json:"media_id,omitempty"`
+	CoverImageUrl string `json:"cover_image_url,omitempty"`
+}
+
+// ImageURLSource returns a PinMediaSource for an image hosted at url.
+func ImageURLSource(url string) PinMediaSource {
+	return PinMediaSource{SourceType: "image_url", Url: url}
+}
+
+// ImageBase64Source returns a PinMediaSource for a base64 encoded image,
+// with a contentType of either image/jpeg or image/png.
+func ImageBase64Source(contentType string, data string) PinMediaSource {
+	return PinMediaSource{SourceType: "image_base64", ContentType: contentType, Data: data}
+}
+
+// VideoSource returns a PinMediaSource for a video that was uploaded
+// through the media endpoints, with the cover image hosted at coverImageUrl.
+func VideoSource(mediaId string, coverImageUrl string) PinMediaSource {
+	return PinMediaSource{SourceType: "video_id", MediaId: mediaId, CoverImageUrl: coverImageUrl}
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/BrandonRomano/iso8601"
+)
+
+// ProductGroup is a struct that represents a group of a catalog's
+// products, selected by Filters.
+//
+// Filters is kept as the JSON the API returns, as it is an arbitrarily
+// nested expression.
+type ProductGroup struct {
+	Id          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	FeedId      string          `json:"feed_id"`
+	Filters     json.RawMessage `json:"filters"`
+	IsFeatured  bool            `json:"is_featured"`
+	Status      string          `json:"status"`
+	CreatedAt   iso8601.Time    `json:"created_at"`
+	UpdatedAt   iso8601.Time    `json:"updated_at"`
+	Raw         Raw             `json:"-"`
+}
@@ -0,0 +1,235 @@
+package models
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The statuses of an asynchronous report.
+const (
+	REPORT_STATUS_IN_PROGRESS    = "IN_PROGRESS"
+	REPORT_STATUS_FINISHED       = "FINISHED"
+	REPORT_STATUS_CANCELLED      = "CANCELLED"
+	REPORT_STATUS_EXPIRED        = "EXPIRED"
+	REPORT_STATUS_DOES_NOT_EXIST = "DOES_NOT_EXIST"
+)
+
+// The formats an asynchronous report can be downloaded in.
+const (
+	REPORT_FORMAT_CSV  = "CSV"
+	REPORT_FORMAT_JSON = "JSON"
+)
+
+// Report is a struct that represents an asynchronous analytics report.
+//
+// Token identifies the report while it is being generated, and Url is
+// where it can be downloaded from once it has FINISHED.
+type Report struct {
+	Token        string `json:"token"`
+	ReportStatus string `json:"report_status"`
+	Url          string `json:"url"`
+	Size         int64  `json:"size"`
+	Message      string `json:"message"`
+	Raw          Raw    `json:"-"`
+}
+
+// ReportRow is a struct that represents a row of an analytics report.
+//
+// Numeric columns are in Metrics, and all other columns (including ids,
+// which are numeric but are not metrics) are in Attributes.
+type ReportRow struct {
+	// EntityId is the id of the entity (such as a campaign) the row
+	// is about.  It is the key the row is grouped under in JSON reports,
+	// or else the most specific entity id column of the row (such as
+	// CAMPAIGN_ID rather than ADVERTISER_ID).
+	EntityId string
+
+	// Date is the day the row is about, or the zero Date when the
+	// report was requested without a daily granularity.
+	Date Date
+
+	Metrics    map[string]float64
+	Attributes map[string]string
+}
+
+// entityIdColumns are the columns that hold the id of the entity a row
+// is about, from the most specific entity to the least.
+var entityIdColumns = []string{
+	"PRODUCT_ITEM_ID",
+	"PRODUCT_GROUP_ID",
+	"KEYWORD_ID",
+	"PIN_PROMOTION_ID",
+	"AD_ID",
+	"AD_GROUP_ID",
+	"CAMPAIGN_ID",
+	"ADVERTISER_ID",
+}
+
+// MicroCurrency returns a metric that is an amount of money in
+// micro currency, such as SPEND_IN_MICRO_DOLLAR.
+func (r ReportRow) MicroCurrency(metric string) MicroCurrency {
+	return MicroCurrency(r.Metrics[metric])
+}
+
+// ParseReport parses a downloaded report in the specified format
+// (REPORT_FORMAT_CSV or REPORT_FORMAT_JSON) into its rows.
+func ParseReport(format string, r io.Reader) ([]ReportRow, error) {
+	switch format {
+	case REPORT_FORMAT_CSV:
+		return ParseReportCSV(r)
+	case REPORT_FORMAT_JSON:
+		return ParseReportJSON(r)
+	}
+	return nil, fmt.Errorf("unknown report format %q", format)
+}
+
+// ParseReportCSV parses a report downloaded in the CSV format,
+// whose first line holds the names of the columns.
+func ParseReportCSV(r io.Reader) ([]ReportRow, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err == io.EOF {
+		return []ReportRow{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []ReportRow{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		row := newReportRow("")
+		for i, column := range header {
+			if i < len(record) {
+				if err := row.set(column, record[i]); err != nil {
+					return nil, err
+				}
+			}
+		}
+		row.EntityId = row.entityIdColumn()
+		rows = append(rows, row)
+	}
+}
+
+// ParseReportJSON parses a report downloaded in the JSON format, which
+// holds either a list of rows, or lists of rows grouped by entity id.
+func ParseReportJSON(r io.Reader) ([]ReportRow, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// A single list of rows
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		rows := []ReportRow{}
+		if err := json.Unmarshal(trimmed, &rows); err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			rows[i].EntityId = rows[i].entityIdColumn()
+		}
+		return rows, nil
+	}
+
+	// Rows grouped by entity id, kept in a stable order
+	grouped := map[string][]ReportRow{}
+	if err := json.Unmarshal(trimmed, &grouped); err != nil {
+		return nil, err
+	}
+	entityIds := make([]string, 0, len(grouped))
+	for entityId := range grouped {
+		entityIds = append(entityIds, entityId)
+	}
+	sort.Strings(entityIds)
+
+	rows := []ReportRow{}
+	for _, entityId := range entityIds {
+		for _, row := range grouped[entityId] {
+			row.EntityId = entityId
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *ReportRow) UnmarshalJSON(data []byte) error {
+	values := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*r = newReportRow(r.EntityId)
+	for column, value := range values {
+		// Keep the text of numbers, so ids aren't rounded as floats
+		text := string(value)
+		if len(value) > 0 && value[0] == '"' {
+			if err := json.Unmarshal(value, &text); err != nil {
+				return err
+			}
+		} else if text == "null" {
+			continue
+		}
+		if err := r.set(column, text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newReportRow instantiates an empty ReportRow.
+func newReportRow(entityId string) ReportRow {
+	return ReportRow{
+		EntityId:   entityId,
+		Metrics:    map[string]float64{},
+		Attributes: map[string]string{},
+	}
+}
+
+// set stores the value of a column in the row.
+func (r *ReportRow) set(column string, value string) error {
+	name := strings.ToUpper(strings.TrimSpace(column))
+	switch {
+	case name == "DATE":
+		date, err := ParseDate(value)
+		if err != nil {
+			return fmt.Errorf("invalid report date %q: %v", value, err)
+		}
+		r.Date = date
+	case name == "ID" || strings.HasSuffix(name, "_ID"):
+		r.Attributes[column] = value
+	default:
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			r.Metrics[column] = number
+		} else {
+			r.Attributes[column] = value
+		}
+	}
+	return nil
+}
+
+// entityIdColumn returns the value of the most specific entity id
+// column of the row, or "" if it has none.
+func (r *ReportRow) entityIdColumn() string {
+	for _, name := range entityIdColumns {
+		for column, value := range r.Attributes {
+			if strings.ToUpper(strings.TrimSpace(column)) == name && value != "" {
+				return value
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,50 @@
+package models_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/carrot/go-pinterest/v5/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseReportJSON tests that JSON reports grouped by entity are
+// parsed into rows, without rounding ids.
+func TestParseReportJSON(t *testing.T) {
+	rows, err := models.ParseReportJSON(strings.NewReader(`{
+		"626735565838": [{"DATE": "2021-03-01", "AD_GROUP_ID": 2680059592705123456, "IMPRESSION_1": 42}]
+	}`))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rows))
+	assert.Equal(t, "626735565838", rows[0].EntityId)
+	assert.Equal(t, "2680059592705123456", rows[0].Attributes["AD_GROUP_ID"])
+	assert.Equal(t, float64(42), rows[0].Metrics["IMPRESSION_1"])
+
+	// Rows that aren't grouped are about their most specific entity
+	rows, err = models.ParseReportJSON(strings.NewReader(`[
+		{"ADVERTISER_ID": "549755885175", "CAMPAIGN_ID": "626735565838", "IMPRESSION_1": 42}
+	]`))
+	assert.Nil(t, err)
+	assert.Equal(t, "626735565838", rows[0].EntityId)
+}
+
+// TestParseReportCSV tests that CSV reports are parsed into rows,
+// each about the entity of its most specific entity id column.
+func TestParseReportCSV(t *testing.T) {
+	rows, err := models.ParseReportCSV(strings.NewReader(
+		"ADVERTISER_ID,CAMPAIGN_ID,DATE,SPEND_IN_MICRO_DOLLAR,CAMPAIGN_NAME\n" +
+			"549755885175,626735565838,2021-03-01,12500000,Spring\n" +
+			"549755885175,626735565839,2021-03-02,0,Summer\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rows))
+	assert.Equal(t, "626735565838", rows[0].EntityId)
+	assert.Equal(t, "2021-03-01", rows[0].Date.String())
+	assert.Equal(t, "Spring", rows[0].Attributes["CAMPAIGN_NAME"])
+	assert.Equal(t, "12.50", rows[0].MicroCurrency("SPEND_IN_MICRO_DOLLAR").String())
+	assert.Equal(t, "626735565839", rows[1].EntityId)
+
+	// Reports without entity ids have rows about no entity
+	rows, err = models.ParseReportCSV(strings.NewReader("DATE,IMPRESSION_1\n2021-03-01,42\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, "", rows[0].EntityId)
+}
@@ -0,0 +1,118 @@
+// Package models contains the models returned by the Pinterest v5 API.
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"reflect"
+
+	v1 "github.com/carrot/go-pinterest/models"
+)
+
+// PinterestError is the same error that the v1 API returns, so errors
+// from either version can be handled the same way.
+type PinterestError = v1.PinterestError
+
+// Raw is the same as the v1 models' Raw, and is only populated by a
+// Client that was set to retain it, with its SetRetainRaw method.
+type Raw = v1.Raw
+
+// Response is the base struct for all responses that come
+// back from the Pinterest v5 API.
+//
+// Unlike v1, v5 responses are not wrapped in a data envelope: objects are
+// returned as they are, and lists are returned as a page of items with
+// a bookmark to the next page.  Data must be set to a pointer to the
+// model (or slice of models) the response should be decoded into.
+type Response struct {
+	Data     interface{}
+	Bookmark string
+	Code     int
+	Message  string
+}
+
+// Page is a struct that holds the bookmark to the next page
+// of a paginated list.  Bookmark is empty on the last page.
+type Page struct {
+	Bookmark string
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *Response) UnmarshalJSON(data []byte) error {
+	// A few endpoints return a list without a page around it
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if r.Data == nil {
+			return nil
+		}
+		return json.Unmarshal(trimmed, r.Data)
+	}
+
+	var envelope struct {
+		Items    json.RawMessage `json:"items"`
+		Bookmark *string         `json:"bookmark"`
+		Code     int             `json:"code"`
+		Message  string          `json:"message"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return err
+	}
+	r.Code = envelope.Code
+	r.Message = envelope.Message
+	if envelope.Bookmark != nil {
+		r.Bookmark = *envelope.Bookmark
+	}
+
+	// Lists are decoded from their items, everything else as-is
+	if r.Data == nil {
+		return nil
+	}
+	if t := reflect.TypeOf(r.Data); t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Slice {
+		if len(envelope.Items) == 0 {
+			return nil
+		}
+		return json.Unmarshal(envelope.Items, r.Data)
+	}
+	return json.Unmarshal(data, r.Data)
+}
+
+// PopulateRaw implements the v1 models' RawPopulator, populating the
+// Raw fields of Data from the same JSON it is decoded from.
+func (r *Response) PopulateRaw(data []byte) {
+	if r.Data == nil {
+		return
+	}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		v1.PopulateRaw(trimmed, r.Data)
+		return
+	}
+	if t := reflect.TypeOf(r.Data); t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Slice {
+		var envelope struct {
+			Items json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(data, &envelope); err == nil && len(envelope.Items) > 0 {
+			v1.PopulateRaw(envelope.Items, r.Data)
+		}
+		return
+	}
+	v1.PopulateRaw(data, r.Data)
+}
+
+// WrapPinterestError takes a *http.Response and a Response and returns a
+// PinterestError if one should be returned.
+func WrapPinterestError(httpResponse *http.Response, bodyResponse *Response, err error) error {
+	if err != nil {
+		return err
+	}
+
+	if !(httpResponse.StatusCode >= 200 && httpResponse.StatusCode < 300) {
+		return &PinterestError{
+			StatusCode: httpResponse.StatusCode,
+			Code:       bodyResponse.Code,
+			Message:    bodyResponse.Message,
+			Limit:      v1.GetRatelimit(httpResponse),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package models
+
+import "sort"
+
+// The time windows that trending keywords can be ranked over.
+const (
+	TREND_TYPE_GROWING  = "growing"
+	TREND_TYPE_MONTHLY  = "monthly"
+	TREND_TYPE_YEARLY   = "yearly"
+	TREND_TYPE_SEASONAL = "seasonal"
+)
+
+// TrendingKeyword is a struct that represents a keyword that is trending
+// in a region, with its growth over the past week, month and year.
+//
+// TimeSeries holds the keyword's relative search volume, keyed by date
+// (such as 2023-01-31).  Use Series to get it in date order.
+type TrendingKeyword struct {
+	Keyword      string             `json:"keyword"`
+	PctGrowthWow float64            `json:"pct_growth_wow"`
+	PctGrowthMom float64            `json:"pct_growth_mom"`
+	PctGrowthYoy float64            `json:"pct_growth_yoy"`
+	TimeSeries   map[string]float64 `json:"time_series"`
+	Raw          Raw                `json:"-"`
+}
+
+// TrendPoint is a single value of a trending keyword's time series.
+type TrendPoint struct {
+	Date  Date
+	Value float64
+}
+
+// Series returns the keyword's time series in date order, ready to
+// be charted.  Dates that can't be parsed are skipped.
+func (m *TrendingKeyword) Series() []TrendPoint {
+	series := make([]TrendPoint, 0, len(m.TimeSeries))
+	for key, value := range m.TimeSeries {
+		date, err := ParseDate(key)
+		if err != nil {
+			continue
+		}
+		series = append(series, TrendPoint{Date: date, Value: value})
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].Date.Before(series[j].Date.Time)
+	})
+	return series
+}
+
+// TrendingKeywords is a struct that represents the
+// top trending keywords in a region.
+type TrendingKeywords struct {
+	Trends []TrendingKeyword `json:"trends"`
+	Raw    Raw               `json:"-"`
+}
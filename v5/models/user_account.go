@@ -0,0 +1,17 @@
+package models
+
+// UserAccount is a struct that represents the authorized
+// user's account from the Pinterest v5 API.
+type UserAccount struct {
+	Username       string `json:"username"`
+	AccountType    string `json:"account_type"`
+	BusinessName   string `json:"business_name"`
+	ProfileImage   string `json:"profile_image"`
+	WebsiteUrl     string `json:"website_url"`
+	BoardCount     int32  `json:"board_count"`
+	PinCount       int32  `json:"pin_count"`
+	FollowerCount  int32  `json:"follower_count"`
+	FollowingCount int32  `json:"following_count"`
+	MonthlyViews   int64  `json:"monthly_views"`
+	Raw            Raw    `json:"-"`
+}
@@ -0,0 +1,289 @@
+// Package v5 is an API client for version 5 of the Pinterest API.
+//
+// It follows the same Client and controller conventions as the v1
+// client in the root package, and shares its retry and error
+// handling, so applications can migrate one endpoint at a time.
+package v5
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/carrot/go-pinterest/internal/rest"
+	"github.com/carrot/go-pinterest/transport"
+	"github.com/carrot/go-pinterest/v5/controllers"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// Client is an API client that connects you with version 5
+// of the Pinterest API.  All v5 API requests will be called
+// through an instance of this struct.
+//
+// Do not instantiate a Client manually, but call the NewClient
+// function, which will return you a properly prepared instance.
+type Client struct {
+	OAuth         *controllers.OAuthController
+	UserAccount   *controllers.UserAccountController
+	Boards        *controllers.BoardsController
+	Pins          *controllers.PinsController
+	Media         *controllers.MediaController
+	AdAccounts    *controllers.AdAccountsController
+	Catalogs      *controllers.CatalogsController
+	Trends        *controllers.TrendsController
+	restClient    *rest.Client
+	storageClient *rest.Client
+	httpClient    *http.Client
+	accessToken   string
+	tokenSource   *oauthTokenSource
+	retryPolicy   transport.RetryPolicy
+	logger        *slog.Logger
+	logLevels     transport.LogLevels
+	middleware    []transport.Middleware
+	coalescing    bool
+	cache         transport.Middleware
+}
+
+// NewClient generates a new instance of a Client, which will
+// allow you to interact with version 5 of the Pinterest API.
+func NewClient() *Client {
+	// Build REST client
+	httpClient := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+	rc := &rest.Client{
+		BaseURL:    "https://api.pinterest.com/v5",
+		HttpClient: httpClient,
+	}
+	sc := &rest.Client{
+		HttpClient: httpClient,
+	}
+
+	// Build Pinterest client
+	pc := &Client{
+		restClient:    rc,
+		storageClient: sc,
+		httpClient:    httpClient,
+	}
+	pc.buildControllers()
+	return pc
+}
+
+// WithContext returns a copy of the Client whose requests are sent with
+// ctx, so that they can be canceled, and traced as part of the caller's
+// work.  The copy has the Client's settings as they are when it is made,
+// and is meant to be used for a single unit of work:
+//
+//	pin, err := client.WithContext(ctx).Pins.Fetch("1234")
+func (pc *Client) WithContext(ctx context.Context) *Client {
+	rc := *pc.restClient
+	rc.Context = ctx
+	sc := *pc.storageClient
+	sc.Context = ctx
+
+	scoped := *pc
+	scoped.restClient = &rc
+	scoped.storageClient = &sc
+	scoped.buildControllers()
+	return &scoped
+}
+
+// buildControllers instantiates the Client's controllers
+// over its REST clients.
+func (pc *Client) buildControllers() {
+	rc, sc := pc.restClient, pc.storageClient
+	pc.OAuth = controllers.NewOAuthController(rc)
+	pc.UserAccount = controllers.NewUserAccountController(rc)
+	pc.Boards = controllers.NewBoardsController(rc)
+	pc.Pins = controllers.NewPinsController(rc)
+	pc.Media = controllers.NewMediaController(rc, sc)
+	pc.AdAccounts = controllers.NewAdAccountsController(rc, sc)
+	pc.Catalogs = controllers.NewCatalogsController(rc)
+	pc.Trends = controllers.NewTrendsController(rc)
+}
+
+// RegisterAccessToken registers an AccessToken on an existing Client.
+// All following requests made with the Client will be authorized with
+// the specified AccessToken, sent as a Bearer token.
+func (pc *Client) RegisterAccessToken(accessToken string) *Client {
+	pc.accessToken = accessToken
+	pc.tokenSource = nil
+	pc.buildHttpClient()
+	return pc
+}
+
+// RegisterOAuthToken registers an AccessToken on an existing Client, which
+// is renewed with its refresh token when it is about to expire, or when
+// the API rejects it.  Tokens are refreshed with the app's client id and
+// secret.
+//
+// onRefresh, which may be nil, is called with each renewed token so it can
+// be persisted.  It is called while other requests wait for the new token,
+// so it should not take long.
+func (pc *Client) RegisterOAuthToken(clientId, clientSecret string, token *models.AccessToken, onRefresh func(*models.AccessToken)) *Client {
+	registered := *token
+	pc.accessToken = ""
+	pc.tokenSource = &oauthTokenSource{
+		token:        &registered,
+		clientId:     clientId,
+		clientSecret: clientSecret,
+		onRefresh:    onRefresh,
+	}
+	pc.buildHttpClient()
+	return pc
+}
+
+// AccessToken returns a copy of the token registered with
+// RegisterOAuthToken, including any renewals, or nil if there is none.
+func (pc *Client) AccessToken() *models.AccessToken {
+	if pc.tokenSource == nil {
+		return nil
+	}
+	return pc.tokenSource.current()
+}
+
+// SetHttpClient sets the underlying http.Client that runs all API requests
+//
+// Its Timeout doesn't apply to media uploads and report downloads, which
+// can take much longer than API calls.  Use WithContext to bound those.
+func (pc *Client) SetHttpClient(client *http.Client) *Client {
+	pc.httpClient = client
+	pc.buildHttpClient()
+	return pc
+}
+
+// SetRetryPolicy enables retrying requests that were rate limited or
+// failed with a transient error, following the specified RetryPolicy.
+// Retries are disabled by default.
+//
+// While retries are enabled, the Timeout of the http.Client set with
+// SetHttpClient applies to each attempt rather than to the request as a
+// whole, so that waiting to retry doesn't use it up.  Use a context (see
+// WithContext) to limit how long a request may take including its retries.
+func (pc *Client) SetRetryPolicy(policy transport.RetryPolicy) *Client {
+	pc.retryPolicy = policy
+	pc.buildHttpClient()
+	return pc
+}
+
+// SetLogger logs every request the Client sends to logger, with its
+// method, operation, path, status, latency, the rate limit remaining and
+// its attempt number, at the levels of its outcome (such as
+// transport.DefaultLogLevels).  Identifiers such as usernames are left out
+// of the logged path (see transport.RedactPath).  Requests aren't logged
+// by default.
+func (pc *Client) SetLogger(logger *slog.Logger, levels transport.LogLevels) *Client {
+	pc.logger = logger
+	pc.logLevels = levels
+	pc.buildHttpClient()
+	return pc
+}
+
+// SetCoalescing enables sending identical GET requests only once while one
+// is in flight, so that goroutines fetching the same pin or board at the
+// same time share a single request (and its rate limit cost).  Each caller
+// still decodes its own copy of the model.  Coalescing is disabled by
+// default.
+func (pc *Client) SetCoalescing(enabled bool) *Client {
+	pc.coalescing = enabled
+	pc.buildHttpClient()
+	return pc
+}
+
+// SetCache caches the responses of the Client's GET requests with cache,
+// such as the Wrap method of a pinterestcache.Cache.  Unlike middleware
+// added with Use, cache is layered below authorization, so that it can
+// tell apart the responses of different users.  Pass nil to stop caching.
+func (pc *Client) SetCache(cache transport.Middleware) *Client {
+	pc.cache = cache
+	pc.buildHttpClient()
+	return pc
+}
+
+// SetRetainRaw toggles whether the models the Client decodes retain
+// their raw JSON payload, and any fields that the model does not
+// recognize, in their Raw field.
+//
+// This is disabled by default, as it roughly doubles the memory used
+// by each decoded model.
+func (pc *Client) SetRetainRaw(retain bool) *Client {
+	pc.restClient.RetainRaw = retain
+	pc.storageClient.RetainRaw = retain
+	return pc
+}
+
+// Use adds middleware to the Client, which wraps every request it sends
+// (see transport.Middleware), such as to add headers, audit requests or
+// measure them (see the pinterestotel and pinterestprom packages).
+//
+// Middleware is layered in the order it is added, above the Client's own
+// RoundTrippers: it sees each request once, however many times it is
+// retried, and before it is authorized.  Requests to storage hosts (such
+// as media uploads) go through it too.
+func (pc *Client) Use(middleware ...transport.Middleware) *Client {
+	pc.middleware = append(pc.middleware, middleware...)
+	pc.buildHttpClient()
+	return pc
+}
+
+// buildHttpClient layers the Client's RoundTrippers over the
+// http.Client set with SetHttpClient.  From the outermost in, they are
+// the middleware, retries, authorization, the cache, coalescing and
+// logging.
+func (pc *Client) buildHttpClient() {
+	logged := *pc.httpClient
+	if pc.logger != nil {
+		logged.Transport = &transport.Logging{
+			Logger: pc.logger,
+			Levels: pc.logLevels,
+			Base:   logged.Transport,
+		}
+	}
+
+	// Media uploads and report downloads go to a storage host, without
+	// authorization.  They can take much longer than API calls, so they
+	// are only bounded by the Client's context, not by its Timeout
+	storage := logged
+	storage.Timeout = 0
+	storage.Transport = transport.Chain(storage.Transport, pc.middleware...)
+	pc.storageClient.HttpClient = &storage
+
+	client := logged
+	if pc.coalescing {
+		client.Transport = &transport.Coalesce{
+			Base: client.Transport,
+		}
+	}
+	if pc.cache != nil {
+		client.Transport = pc.cache(client.Transport)
+	}
+	if pc.tokenSource != nil {
+		// Tokens are refreshed without the Client's authorization
+		pc.tokenSource.tokens = controllers.NewOAuthController(&rest.Client{
+			BaseURL:    pc.restClient.BaseURL,
+			HttpClient: &logged,
+		}).Token
+		client.Transport = &transport.RefreshingBearerAuth{
+			Source: pc.tokenSource,
+			Base:   client.Transport,
+		}
+	} else if pc.accessToken != "" {
+		client.Transport = &transport.BearerAuth{
+			Token: pc.accessToken,
+			Base:  client.Transport,
+		}
+	}
+	if pc.retryPolicy.MaxAttempts > 1 {
+		// The http.Client's Timeout limits each attempt, rather than
+		// all of them and the waits in between
+		client.Transport = &transport.Retry{
+			Policy:         pc.retryPolicy,
+			Base:           client.Transport,
+			AttemptTimeout: client.Timeout,
+		}
+		client.Timeout = 0
+	}
+	client.Transport = transport.Chain(client.Transport, pc.middleware...)
+	pc.restClient.HttpClient = &client
+}
@@ -0,0 +1,36 @@
+package v5
+
+import (
+	"context"
+	"time"
+)
+
+// poll calls check every interval until it returns true or an error.
+// It returns false if timeout passes first, and the error of the
+// Client's context (see WithContext) if that is done first.
+func (pc *Client) poll(interval, timeout time.Duration, check func() (bool, error)) (bool, error) {
+	ctx := pc.restClient.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := check()
+		if done || err != nil {
+			return done, err
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return false, nil
+		}
+
+		// Wait, unless the context is done first
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package v5
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/carrot/go-pinterest/v5/controllers"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// RunReportOptionals is a struct that represents the optional parameters
+// that can be passed to RunReport and ResumeReport
+type RunReportOptionals struct {
+	// Report holds the optional parameters of the requested report.
+	Report *controllers.ReportCreateOptionals
+
+	// PollInterval is how often the report's status is checked.
+	// Defaults to 5 seconds.
+	PollInterval time.Duration
+
+	// Timeout is how long to wait for the report to be generated.
+	// Defaults to 10 minutes.
+	Timeout time.Duration
+}
+
+// ReportError is the error returned when a report could not be run.
+// If the report was requested, Token can be passed to ResumeReport to
+// keep waiting for it without requesting it again.
+type ReportError struct {
+	Token        string
+	ReportStatus string
+	Err          error
+}
+
+// Error returns the error message of a ReportError.
+func (e *ReportError) Error() string {
+	return fmt.Sprintf("report %s failed (status: %s): %v", e.Token, e.ReportStatus, e.Err)
+}
+
+// Unwrap returns the error that caused the report to fail.
+func (e *ReportError) Unwrap() error {
+	return e.Err
+}
+
+// RunReport requests an asynchronous report of an ad account's metrics
+// over a range of dates, waits for it to be generated, and downloads
+// and parses its rows.
+func (pc *Client) RunReport(adAccountId string, startDate time.Time, endDate time.Time, columns []string, optionals *RunReportOptionals) ([]models.ReportRow, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &RunReportOptionals{}
+	}
+
+	// Request the report
+	report, err := pc.AdAccounts.Reports.Create(adAccountId, startDate, endDate, columns, optionals.Report)
+	if err != nil {
+		return nil, &ReportError{Err: err}
+	}
+	return pc.ResumeReport(adAccountId, report.Token, optionals)
+}
+
+// ResumeReport waits for a report that was already requested to be
+// generated, and downloads and parses its rows, such as after RunReport
+// timed out waiting on a large report.  It stops waiting early if the
+// Client's context (see WithContext) is done.
+//
+// optionals.Report.ReportFormat must match the format the report
+// was requested in.
+func (pc *Client) ResumeReport(adAccountId string, token string, optionals *RunReportOptionals) ([]models.ReportRow, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &RunReportOptionals{}
+	}
+	format := models.REPORT_FORMAT_JSON
+	if optionals.Report != nil && optionals.Report.ReportFormat != "" {
+		format = optionals.Report.ReportFormat
+	}
+	pollInterval := optionals.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = 5 * time.Second
+	}
+	timeout := optionals.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Minute
+	}
+
+	// Wait for the report to be generated
+	var report *models.Report
+	generated, err := pc.poll(pollInterval, timeout, func() (bool, error) {
+		fetched, err := pc.AdAccounts.Reports.Fetch(adAccountId, token)
+		if err != nil {
+			return false, err
+		}
+		report = fetched
+		switch report.ReportStatus {
+		case models.REPORT_STATUS_CANCELLED, models.REPORT_STATUS_EXPIRED, models.REPORT_STATUS_DOES_NOT_EXIST:
+			return false, fmt.Errorf("the report will not be generated")
+		}
+		return report.ReportStatus == models.REPORT_STATUS_FINISHED, nil
+	})
+	if err != nil {
+		reportError := &ReportError{Token: token, Err: err}
+		if report != nil {
+			reportError.ReportStatus = report.ReportStatus
+		}
+		return nil, reportError
+	}
+	if !generated {
+		return nil, &ReportError{
+			Token:        token,
+			ReportStatus: report.ReportStatus,
+			Err:          fmt.Errorf("timed out after %s", timeout),
+		}
+	}
+
+	// Download it
+	rows, err := pc.AdAccounts.Reports.Download(report, format)
+	if err != nil {
+		return nil, &ReportError{Token: token, ReportStatus: report.ReportStatus, Err: err}
+	}
+	return *rows, nil
+}
@@ -0,0 +1,81 @@
+package v5
+
+import (
+	"sync"
+	"time"
+
+	"github.com/carrot/go-pinterest/v5/controllers"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// refreshBeforeExpiry is how long before an access token expires
+// that it is refreshed.
+const refreshBeforeExpiry = time.Minute
+
+// oauthTokenSource is a transport.TokenSource that refreshes an
+// AccessToken with the Pinterest API.
+type oauthTokenSource struct {
+	mutex        sync.Mutex
+	token        *models.AccessToken
+	clientId     string
+	clientSecret string
+	onRefresh    func(*models.AccessToken)
+	tokens       *controllers.OAuthTokenController
+}
+
+// Token implements transport.TokenSource.
+func (ts *oauthTokenSource) Token() (string, error) {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	if ts.token.ExpiresWithin(refreshBeforeExpiry) && ts.token.CanRefresh() {
+		if err := ts.refresh(); err != nil && ts.token.ExpiresWithin(0) {
+			return "", err
+		}
+	}
+	return ts.token.AccessToken, nil
+}
+
+// Refresh implements transport.TokenSource.
+func (ts *oauthTokenSource) Refresh(stale string) (string, error) {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	if ts.token.AccessToken == stale && ts.token.CanRefresh() {
+		if err := ts.refresh(); err != nil {
+			return "", err
+		}
+	}
+	return ts.token.AccessToken, nil
+}
+
+// refresh renews the token, and hands it to onRefresh to be persisted.
+// ts.mutex must be held.
+func (ts *oauthTokenSource) refresh() error {
+	token, err := ts.tokens.Refresh(ts.clientId, ts.clientSecret, ts.token.RefreshToken)
+	if err != nil {
+		return err
+	}
+
+	// Pinterest only returns a new refresh token when the old one is
+	// about to expire, so keep using the old one until then
+	if token.RefreshToken == "" {
+		token.RefreshToken = ts.token.RefreshToken
+		token.RefreshTokenExpiresIn = ts.token.RefreshTokenExpiresIn
+		token.RefreshTokenExpiresAt = ts.token.RefreshTokenExpiresAt
+	}
+	ts.token = token
+	if ts.onRefresh != nil {
+		persisted := *token
+		ts.onRefresh(&persisted)
+	}
+	return nil
+}
+
+// current returns a copy of the current token.
+func (ts *oauthTokenSource) current() *models.AccessToken {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+	token := *ts.token
+	return &token
+}
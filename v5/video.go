@@ -0,0 +1,161 @@
+package v5
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/carrot/go-pinterest/v5/controllers"
+	"github.com/carrot/go-pinterest/v5/models"
+)
+
+// The stages of a video upload, as reported to UploadVideoOptionals.Progress.
+const (
+	VIDEO_STAGE_REGISTERED = "registered"
+	VIDEO_STAGE_UPLOADING  = "uploading"
+	VIDEO_STAGE_PROCESSING = "processing"
+	VIDEO_STAGE_CREATED    = "created"
+)
+
+// VideoProgress is a struct that describes how far along a video upload is.
+type VideoProgress struct {
+	Stage   string
+	MediaId string
+
+	// Sent and Total are the bytes of the video uploaded so far,
+	// and the size of the video.
+	Sent  int64
+	Total int64
+
+	// Status is the latest media status reported by Pinterest
+	// while the video is processing.
+	Status string
+}
+
+// UploadVideoOptionals is a struct that represents the optional parameters
+// that can be passed to UploadVideo and ResumeVideoUpload
+type UploadVideoOptionals struct {
+	// Pin holds the optional parameters of the created pin.
+	Pin *controllers.PinCreateOptionals
+
+	// Progress, if set, is called as the upload moves along.
+	Progress func(VideoProgress)
+
+	// PollInterval is how often the processing status is checked.
+	// Defaults to 5 seconds.
+	PollInterval time.Duration
+
+	// Timeout is how long to wait for the video to be processed.
+	// Defaults to 10 minutes.
+	Timeout time.Duration
+}
+
+// VideoUploadError is the error returned when a video upload did not
+// complete.  If the video was registered, MediaId can be passed to
+// ResumeVideoUpload to wait for it to be processed without uploading it
+// again.
+type VideoUploadError struct {
+	MediaId string
+	Stage   string
+	Err     error
+}
+
+// Error returns the error message of a VideoUploadError.
+func (e *VideoUploadError) Error() string {
+	return fmt.Sprintf("video upload failed while %s (media %s): %v", e.Stage, e.MediaId, e.Err)
+}
+
+// Unwrap returns the error that caused the upload to fail.
+func (e *VideoUploadError) Unwrap() error {
+	return e.Err
+}
+
+// UploadVideo publishes a video pin.  It registers the video with
+// Pinterest, uploads it, waits for it to be processed, and creates a pin
+// on the board with the cover image hosted at coverImageUrl.
+//
+// size must be the exact length of video.  If Pinterest fails to process
+// the video, the returned VideoUploadError wraps a
+// *models.MediaProcessingError.
+func (pc *Client) UploadVideo(boardId string, video io.Reader, size int64, coverImageUrl string, optionals *UploadVideoOptionals) (*models.Pin, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &UploadVideoOptionals{}
+	}
+
+	// Register the video
+	upload, err := pc.Media.Create("video")
+	if err != nil {
+		return nil, &VideoUploadError{Stage: VIDEO_STAGE_REGISTERED, Err: err}
+	}
+	reportVideoProgress(optionals, VideoProgress{Stage: VIDEO_STAGE_REGISTERED, MediaId: upload.MediaId, Total: size})
+
+	// Upload it
+	err = pc.Media.Upload(upload, video, size, func(sent int64) {
+		reportVideoProgress(optionals, VideoProgress{Stage: VIDEO_STAGE_UPLOADING, MediaId: upload.MediaId, Sent: sent, Total: size})
+	})
+	if err != nil {
+		return nil, &VideoUploadError{MediaId: upload.MediaId, Stage: VIDEO_STAGE_UPLOADING, Err: err}
+	}
+
+	return pc.ResumeVideoUpload(upload.MediaId, boardId, coverImageUrl, optionals)
+}
+
+// ResumeVideoUpload waits for a video that was already uploaded to be
+// processed, and creates a pin from it, such as after UploadVideo timed
+// out waiting on a long video.  It stops waiting early if the Client's
+// context (see WithContext) is done.
+func (pc *Client) ResumeVideoUpload(mediaId string, boardId string, coverImageUrl string, optionals *UploadVideoOptionals) (*models.Pin, error) {
+	// Default optionals
+	if optionals == nil {
+		optionals = &UploadVideoOptionals{}
+	}
+	pollInterval := optionals.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = 5 * time.Second
+	}
+	timeout := optionals.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Minute
+	}
+
+	// Wait for the video to be processed
+	var status string
+	processed, err := pc.poll(pollInterval, timeout, func() (bool, error) {
+		media, err := pc.Media.Fetch(mediaId)
+		if err != nil {
+			return false, err
+		}
+		status = media.Status
+		reportVideoProgress(optionals, VideoProgress{Stage: VIDEO_STAGE_PROCESSING, MediaId: mediaId, Status: status})
+		if status == models.MEDIA_STATUS_FAILED {
+			return false, &models.MediaProcessingError{MediaId: mediaId, Status: status}
+		}
+		return status == models.MEDIA_STATUS_SUCCEEDED, nil
+	})
+	if err != nil {
+		return nil, &VideoUploadError{MediaId: mediaId, Stage: VIDEO_STAGE_PROCESSING, Err: err}
+	}
+	if !processed {
+		return nil, &VideoUploadError{
+			MediaId: mediaId,
+			Stage:   VIDEO_STAGE_PROCESSING,
+			Err:     fmt.Errorf("timed out after %s (status: %s)", timeout, status),
+		}
+	}
+
+	// Create the pin
+	pin, err := pc.Pins.Create(boardId, models.VideoSource(mediaId, coverImageUrl), optionals.Pin)
+	if err != nil {
+		return nil, &VideoUploadError{MediaId: mediaId, Stage: VIDEO_STAGE_CREATED, Err: err}
+	}
+	reportVideoProgress(optionals, VideoProgress{Stage: VIDEO_STAGE_CREATED, MediaId: mediaId})
+	return pin, nil
+}
+
+// reportVideoProgress calls the Progress optional, if it is set.
+func reportVideoProgress(optionals *UploadVideoOptionals, progress VideoProgress) {
+	if optionals.Progress != nil {
+		optionals.Progress(progress)
+	}
+}